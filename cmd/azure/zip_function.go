@@ -97,6 +97,9 @@ func (r resolveFunctionList) functions() []types.Function {
 	ret := make([]types.Function, len(r))
 	for _, v := range r {
 		ret = append(ret, v.Resolve)
+		if v.Authorize != nil {
+			ret = append(ret, *v.Authorize)
+		}
 	}
 	return ret
 }
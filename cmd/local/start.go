@@ -17,12 +17,17 @@ limitations under the License.
 package localcmd
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	crs "github.com/graphql-editor/stucco/pkg/cors"
 	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-editor/stucco/pkg/pubsub"
+	"github.com/graphql-editor/stucco/pkg/router"
 	"github.com/graphql-editor/stucco/pkg/server"
 	"github.com/graphql-editor/stucco/pkg/utils"
 	"github.com/rs/cors"
@@ -41,30 +46,77 @@ func (klogErrorf) Errorf(msg string, args ...interface{}) {
 func NewStartCommand() *cobra.Command {
 	var startConfig string
 	var schema string
+	var watch, watchRemote bool
+	var watchInterval time.Duration
+	var mockMissing bool
+	var mockFixtures string
+	var mockSeed int64
 	startCommand := &cobra.Command{
 		Use:   "start",
 		Short: "Start local runner",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var cfg server.Config
-			if err := utils.LoadConfigFile(startConfig, &cfg); err != nil {
-				return err
-			}
-			if schema != "" {
-				cfg.Schema = schema
+			var remoteConfig *server.Config
+			// broker is shared by routers of reloaded configs and publish handler
+			broker := pubsub.New()
+			load := func() (cfg server.Config, err error) {
+				if remoteConfig != nil {
+					return *remoteConfig, nil
+				}
+				if err = utils.LoadConfigFile(startConfig, &cfg); err != nil {
+					return
+				}
+				cfg.Broker = broker
+				if schema != "" {
+					cfg.Schema = schema
+				}
+				if mockMissing || mockFixtures != "" || mockSeed != 0 {
+					if cfg.Mock == nil {
+						cfg.Mock = &router.MockConfig{}
+					}
+					cfg.Mock.Missing = cfg.Mock.Missing || mockMissing
+					if mockFixtures != "" {
+						cfg.Mock.Fixtures = mockFixtures
+					}
+					if mockSeed != 0 {
+						cfg.Mock.Seed = mockSeed
+					}
+				}
+				// remote config is loaded only once unless polling of remote sources is enabled
+				if !watchRemote && (strings.HasPrefix(startConfig, "http://") || strings.HasPrefix(startConfig, "https://")) {
+					remoteConfig = &cfg
+				}
+				return
 			}
 			dri := server.NewDefaultDrivers()
 			if err := dri.Load(); err != nil {
 				return err
 			}
 			defer dri.Close()
-			h, err := server.New(cfg)
+			reloader, err := server.NewReloader(server.ReloaderConfig{
+				Load:       load,
+				Interval:   watchInterval,
+				PollRemote: watchRemote,
+			})
+			if err != nil {
+				return err
+			}
+			h, err := reloader.Handler(server.New)
 			if err != nil {
 				return err
 			}
-			webhookHandler, err := server.NewWebhookHandler(cfg)
+			webhookHandler, err := reloader.Handler(server.NewWebhookHandler)
 			if err != nil {
 				return err
 			}
+			publishHandler, err := reloader.Handler(server.NewPublishHandler)
+			if err != nil {
+				return err
+			}
+			if watch {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				go reloader.Watch(ctx)
+			}
 			corsOptions := crs.NewCors()
 			fmt.Println(corsOptions.AllowedOrigins)
 			middleware := func(next http.Handler) http.Handler {
@@ -83,9 +135,11 @@ func NewStartCommand() *cobra.Command {
 			}
 			h = middleware(h)
 			webhookHandler = middleware(webhookHandler)
+			publishHandler = middleware(publishHandler)
 			srv := server.Server{
 				Handler:        h,
 				WebhookHandler: webhookHandler,
+				PublishHandler: publishHandler,
 				Addr:           ":8080",
 			}
 			return srv.ListenAndServe()
@@ -102,5 +156,11 @@ func NewStartCommand() *cobra.Command {
 	startCommand.Flags().AddGoFlagSet(klogFlagSet)
 	startCommand.Flags().StringVarP(&startConfig, "config", "c", "", "path to stucco config")
 	startCommand.Flags().StringVarP(&schema, "schema", "s", "", "path to stucco config")
+	startCommand.Flags().BoolVarP(&watch, "watch", "w", false, "reload schema and config on change")
+	startCommand.Flags().DurationVar(&watchInterval, "watch-interval", 2*time.Second, "interval between checks for schema and config changes")
+	startCommand.Flags().BoolVar(&watchRemote, "watch-remote", false, "poll schema and config fetched from url for changes")
+	startCommand.Flags().BoolVar(&mockMissing, "mock-missing", false, "resolve fields without resolver with values generated from schema")
+	startCommand.Flags().StringVar(&mockFixtures, "mock-fixtures", "", "path to file with values of mocked fields keyed by Type.field")
+	startCommand.Flags().Int64Var(&mockSeed, "mock-seed", 0, "seed of mocked values")
 	return startCommand
 }
@@ -0,0 +1,124 @@
+// Package safelistcmd is a safelist command
+package safelistcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/graphql-editor/stucco/pkg/parser"
+	"github.com/graphql-editor/stucco/pkg/safelist"
+	"github.com/graphql-editor/stucco/pkg/server"
+	"github.com/graphql-editor/stucco/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewSafelistCommand creates new safelist command
+func NewSafelistCommand() *cobra.Command {
+	safelistCommand := &cobra.Command{
+		Use:   "safelist",
+		Short: "Manage manifest of allowed operations",
+	}
+	safelistCommand.AddCommand(NewBuildCommand())
+	safelistCommand.AddCommand(NewValidateCommand())
+	return safelistCommand
+}
+
+func validate(m *safelist.Manifest, config, schema string) error {
+	var cfg server.Config
+	// config is optional if schema is passed explicitly
+	if config != "" || schema == "" {
+		if err := utils.LoadConfigFile(config, &cfg); err != nil {
+			return err
+		}
+	}
+	if schema != "" {
+		cfg.Schema = schema
+	}
+	source, err := cfg.RawSchema()
+	if err != nil {
+		return err
+	}
+	p := parser.NewParser(parser.Config{})
+	s, err := p.Parse(source)
+	if err != nil {
+		return err
+	}
+	return m.Validate(s)
+}
+
+// NewBuildCommand creates a build command
+func NewBuildCommand() *cobra.Command {
+	var output, config, schema string
+	var skipValidation bool
+	buildCommand := &cobra.Command{
+		Use:   "build [directory]",
+		Short: "Build manifest from directory of .graphql files",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := safelist.BuildManifest(args[0])
+			if err != nil {
+				return err
+			}
+			if !skipValidation {
+				if err := validate(m, config, schema); err != nil {
+					return err
+				}
+			}
+			b, err := json.MarshalIndent(m, "", "\t")
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				_, err = fmt.Fprintln(os.Stdout, string(b))
+				return err
+			}
+			return ioutil.WriteFile(output, append(b, '\n'), 0644)
+		},
+	}
+	buildCommand.Flags().StringVarP(&output, "output", "o", "", "path to output manifest, stdout if empty")
+	buildCommand.Flags().StringVarP(&config, "config", "c", "", "path to stucco config")
+	buildCommand.Flags().StringVarP(&schema, "schema", "s", "", "path to schema")
+	buildCommand.Flags().BoolVar(&skipValidation, "skip-validation", false, "do not validate operations against schema")
+	return buildCommand
+}
+
+// NewValidateCommand creates a validate command
+func NewValidateCommand() *cobra.Command {
+	var config, schema string
+	validateCommand := &cobra.Command{
+		Use:   "validate [manifest]",
+		Short: "Validate manifest against schema",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var manifest string
+			if len(args) > 0 {
+				manifest = args[0]
+			}
+			if manifest == "" {
+				var cfg server.Config
+				if err := utils.LoadConfigFile(config, &cfg); err != nil {
+					return err
+				}
+				if cfg.Safelist == nil || cfg.Safelist.Manifest == "" {
+					return errors.New("manifest path is required")
+				}
+				manifest = cfg.Safelist.Manifest
+			}
+			m, err := safelist.LoadManifest(manifest)
+			if err != nil {
+				return err
+			}
+			if err := validate(m, config, schema); err != nil {
+				return err
+			}
+			fmt.Printf("%d operations are valid\n", len(m.Operations))
+			return nil
+		},
+	}
+	validateCommand.Flags().StringVarP(&config, "config", "c", "", "path to stucco config")
+	validateCommand.Flags().StringVarP(&schema, "schema", "s", "", "path to schema")
+	return validateCommand
+}
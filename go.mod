@@ -13,7 +13,7 @@ require (
 	github.com/google/gofuzz v1.2.0 // indirect
 	github.com/google/uuid v1.3.0 // indirect
 	github.com/gorilla/websocket v1.5.0
-	github.com/graphql-editor/stucco_proto v0.7.21
+	github.com/graphql-editor/stucco_proto v0.7.22
 	github.com/graphql-go/graphql v0.8.0
 	github.com/graphql-go/handler v0.2.3
 	github.com/hashicorp/go-hclog v1.2.1
@@ -50,3 +50,9 @@ require (
 )
 
 replace github.com/graphql-go/graphql => github.com/graphql-editor/graphql v0.7.10-0.20220715103515-dd2af00bb70d
+
+// Go module of stucco_proto v0.7.22 (FieldResolveBatch, claims and error code,
+// extensions and path) is kept in tree until the tag is released. Replace is
+// ignored by modules importing stucco, so it must be removed with third_party
+// as soon as github.com/graphql-editor/stucco_proto v0.7.22 is tagged.
+replace github.com/graphql-editor/stucco_proto => ./third_party/stucco_proto
@@ -33,6 +33,7 @@ import (
 	"github.com/graphql-editor/stucco/cmd"
 	azurecmd "github.com/graphql-editor/stucco/cmd/azure"
 	localcmd "github.com/graphql-editor/stucco/cmd/local"
+	safelistcmd "github.com/graphql-editor/stucco/cmd/safelist"
 	"github.com/spf13/cobra"
 
 	configcmd "github.com/graphql-editor/stucco/cmd/config"
@@ -61,6 +62,7 @@ func main() {
 	rootCmd.AddCommand(azurecmd.NewAzureCommand())
 	rootCmd.AddCommand(localcmd.NewLocalCommand())
 	rootCmd.AddCommand(configcmd.NewConfigCommand())
+	rootCmd.AddCommand(safelistcmd.NewSafelistCommand())
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -9,10 +9,19 @@ type AuthorizeInput struct {
 	OperationName  string                 `json:"operationName,omitempty"`
 	VariableValues map[string]interface{} `json:"variableValues,omitempty"`
 	Protocol       interface{}            `json:"protocol,omitempty"`
+	// Source, Arguments and Info are set only for field level authorization
+	Source    interface{}       `json:"source,omitempty"`
+	Arguments types.Arguments   `json:"arguments,omitempty"`
+	Info      *FieldResolveInfo `json:"info,omitempty"`
+	// Claims returned by request authorize function, set only for field level authorization
+	Claims interface{} `json:"claims,omitempty"`
 }
 
 // AuthorizeOutput is an authorize response
 type AuthorizeOutput struct {
 	Response bool   `json:"response,omitempty"`
 	Error    *Error `json:"error,omitempty"`
+	// Claims describe identity of authorized request, they are passed
+	// to all functions called in that request
+	Claims interface{} `json:"claims,omitempty"`
 }
@@ -0,0 +1,59 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// BatchDriver is a Driver that can resolve multiple fields in one call.
+type BatchDriver interface {
+	// FieldResolveBatch requests an execution of defined resolvers for a list of fields
+	FieldResolveBatch(FieldResolveBatchInput) FieldResolveBatchOutput
+	// FieldResolveBatchContext requests an execution of defined resolvers for a list of fields
+	FieldResolveBatchContext(context.Context, FieldResolveBatchInput) FieldResolveBatchOutput
+}
+
+// FieldResolveBatch resolves all inputs using driver. If driver implements
+// BatchDriver, inputs are sent in one call, otherwise each input is resolved
+// separately.
+func FieldResolveBatch(ctx context.Context, d Driver, in FieldResolveBatchInput) FieldResolveBatchOutput {
+	if bd, ok := d.(BatchDriver); ok {
+		out := bd.FieldResolveBatchContext(ctx, in)
+		if out.Error == nil && len(out.Outputs) != len(in.Inputs) {
+			out = FieldResolveBatchOutput{
+				Error: &Error{
+					Message: fmt.Sprintf("expected %d outputs in batch, got %d", len(in.Inputs), len(out.Outputs)),
+				},
+			}
+		}
+		return out
+	}
+	cd := WithContext(d)
+	out := FieldResolveBatchOutput{
+		Outputs: make([]FieldResolveOutput, 0, len(in.Inputs)),
+	}
+	for _, input := range in.Inputs {
+		out.Outputs = append(out.Outputs, cd.FieldResolveContext(ctx, input))
+	}
+	return out
+}
+
+// FieldResolveEach resolves each input in batch with a separate call to
+// FieldResolveContext. Calls are made concurrently. It can be used by BatchDriver
+// implementations when the other side cannot handle a batch in one call.
+func FieldResolveEach(ctx context.Context, d ContextDriver, in FieldResolveBatchInput) FieldResolveBatchOutput {
+	out := FieldResolveBatchOutput{
+		Outputs: make([]FieldResolveOutput, len(in.Inputs)),
+	}
+	var wg sync.WaitGroup
+	wg.Add(len(in.Inputs))
+	for i := range in.Inputs {
+		go func(i int) {
+			defer wg.Done()
+			out.Outputs[i] = d.FieldResolveContext(ctx, in.Inputs[i])
+		}(i)
+	}
+	wg.Wait()
+	return out
+}
@@ -0,0 +1,55 @@
+package driver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldResolveBatch(t *testing.T) {
+	inputs := []driver.FieldResolveInput{
+		{Function: types.Function{Name: "a"}},
+		{Function: types.Function{Name: "b"}},
+	}
+	t.Run("FallbackToFieldResolve", func(t *testing.T) {
+		mockDriver := new(drivertest.MockDriver)
+		mockDriver.On("FieldResolve", inputs[0]).Return(driver.FieldResolveOutput{Response: "a"})
+		mockDriver.On("FieldResolve", inputs[1]).Return(driver.FieldResolveOutput{Response: "b"})
+		out := driver.FieldResolveBatch(context.Background(), mockDriver, driver.FieldResolveBatchInput{
+			Inputs: inputs,
+		})
+		assert.Equal(t, driver.FieldResolveBatchOutput{
+			Outputs: []driver.FieldResolveOutput{
+				{Response: "a"},
+				{Response: "b"},
+			},
+		}, out)
+	})
+	t.Run("UsesBatchDriver", func(t *testing.T) {
+		mockDriver := new(drivertest.MockBatchDriver)
+		in := driver.FieldResolveBatchInput{Inputs: inputs}
+		expected := driver.FieldResolveBatchOutput{
+			Outputs: []driver.FieldResolveOutput{
+				{Response: "a"},
+				{Response: "b"},
+			},
+		}
+		mockDriver.On("FieldResolveBatch", in).Return(expected)
+		out := driver.FieldResolveBatch(context.Background(), mockDriver, in)
+		assert.Equal(t, expected, out)
+		mockDriver.AssertNotCalled(t, "FieldResolve", inputs[0])
+	})
+	t.Run("OutputsLengthMismatch", func(t *testing.T) {
+		mockDriver := new(drivertest.MockBatchDriver)
+		in := driver.FieldResolveBatchInput{Inputs: inputs}
+		mockDriver.On("FieldResolveBatch", in).Return(driver.FieldResolveBatchOutput{
+			Outputs: []driver.FieldResolveOutput{{Response: "a"}},
+		})
+		out := driver.FieldResolveBatch(context.Background(), mockDriver, in)
+		assert.NotNil(t, out.Error)
+	})
+}
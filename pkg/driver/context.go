@@ -0,0 +1,112 @@
+package driver
+
+import "context"
+
+// ContextDriver is a Driver that accepts a context with every call.
+// Implementations should abort work and return as soon as possible
+// when context is done.
+type ContextDriver interface {
+	Driver
+	// AuthorizeContext runs a custom auth code on function
+	AuthorizeContext(context.Context, AuthorizeInput) AuthorizeOutput
+	// FieldResolveContext requests an execution of defined resolver for a field
+	FieldResolveContext(context.Context, FieldResolveInput) FieldResolveOutput
+	// InterfaceResolveTypeContext requests an execution of defined interface function for a type
+	InterfaceResolveTypeContext(context.Context, InterfaceResolveTypeInput) InterfaceResolveTypeOutput
+	// ScalarParseContext requests an execution of defined parse function for a scalar
+	ScalarParseContext(context.Context, ScalarParseInput) ScalarParseOutput
+	// ScalarSerializeContext requests an execution of defined serialize function for a scalar
+	ScalarSerializeContext(context.Context, ScalarSerializeInput) ScalarSerializeOutput
+	// UnionResolveTypeContext requests an execution of defined union function for a type
+	UnionResolveTypeContext(context.Context, UnionResolveTypeInput) UnionResolveTypeOutput
+	// StreamContext begins streaming data between router and runner.
+	StreamContext(context.Context, StreamInput) StreamOutput
+	// SubscriptionConnectionContext creates connection payload for subscription
+	SubscriptionConnectionContext(context.Context, SubscriptionConnectionInput) SubscriptionConnectionOutput
+	// SubscriptionListenContext creates connection payload for subscription
+	SubscriptionListenContext(context.Context, SubscriptionListenInput) SubscriptionListenOutput
+}
+
+// WithContext returns driver as ContextDriver. If driver does not
+// implement ContextDriver, it is wrapped in an adapter which
+// checks context before calling driver and otherwise ignores it.
+func WithContext(d Driver) ContextDriver {
+	if cd, ok := d.(ContextDriver); ok {
+		return cd
+	}
+	return contextAdapter{d}
+}
+
+func contextError(ctx context.Context) *Error {
+	if ctx == nil || ctx.Err() == nil {
+		return nil
+	}
+	return &Error{Message: ctx.Err().Error()}
+}
+
+type contextAdapter struct {
+	Driver
+}
+
+func (c contextAdapter) AuthorizeContext(ctx context.Context, in AuthorizeInput) AuthorizeOutput {
+	if err := contextError(ctx); err != nil {
+		return AuthorizeOutput{Error: err}
+	}
+	return c.Authorize(in)
+}
+
+func (c contextAdapter) FieldResolveContext(ctx context.Context, in FieldResolveInput) FieldResolveOutput {
+	if err := contextError(ctx); err != nil {
+		return FieldResolveOutput{Error: err}
+	}
+	return c.FieldResolve(in)
+}
+
+func (c contextAdapter) InterfaceResolveTypeContext(ctx context.Context, in InterfaceResolveTypeInput) InterfaceResolveTypeOutput {
+	if err := contextError(ctx); err != nil {
+		return InterfaceResolveTypeOutput{Error: err}
+	}
+	return c.InterfaceResolveType(in)
+}
+
+func (c contextAdapter) ScalarParseContext(ctx context.Context, in ScalarParseInput) ScalarParseOutput {
+	if err := contextError(ctx); err != nil {
+		return ScalarParseOutput{Error: err}
+	}
+	return c.ScalarParse(in)
+}
+
+func (c contextAdapter) ScalarSerializeContext(ctx context.Context, in ScalarSerializeInput) ScalarSerializeOutput {
+	if err := contextError(ctx); err != nil {
+		return ScalarSerializeOutput{Error: err}
+	}
+	return c.ScalarSerialize(in)
+}
+
+func (c contextAdapter) UnionResolveTypeContext(ctx context.Context, in UnionResolveTypeInput) UnionResolveTypeOutput {
+	if err := contextError(ctx); err != nil {
+		return UnionResolveTypeOutput{Error: err}
+	}
+	return c.UnionResolveType(in)
+}
+
+func (c contextAdapter) StreamContext(ctx context.Context, in StreamInput) StreamOutput {
+	if err := contextError(ctx); err != nil {
+		return StreamOutput{Error: err}
+	}
+	return c.Stream(in)
+}
+
+func (c contextAdapter) SubscriptionConnectionContext(ctx context.Context, in SubscriptionConnectionInput) SubscriptionConnectionOutput {
+	if err := contextError(ctx); err != nil {
+		return SubscriptionConnectionOutput{Error: err}
+	}
+	return c.SubscriptionConnection(in)
+}
+
+func (c contextAdapter) SubscriptionListenContext(ctx context.Context, in SubscriptionListenInput) SubscriptionListenOutput {
+	if err := contextError(ctx); err != nil {
+		return SubscriptionListenOutput{Error: err}
+	}
+	return c.SubscriptionListen(in)
+}
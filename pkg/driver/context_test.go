@@ -0,0 +1,34 @@
+package driver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithContext(t *testing.T) {
+	t.Run("ReturnsContextDriver", func(t *testing.T) {
+		cd := driver.WithContext(new(drivertest.MockDriver))
+		assert.Equal(t, cd, driver.WithContext(cd))
+	})
+	t.Run("CallsDriver", func(t *testing.T) {
+		mockDriver := new(drivertest.MockDriver)
+		mockDriver.On("FieldResolve", driver.FieldResolveInput{}).Return(driver.FieldResolveOutput{
+			Response: "response",
+		})
+		out := driver.WithContext(mockDriver).FieldResolveContext(context.Background(), driver.FieldResolveInput{})
+		assert.Equal(t, driver.FieldResolveOutput{Response: "response"}, out)
+		mockDriver.AssertCalled(t, "FieldResolve", driver.FieldResolveInput{})
+	})
+	t.Run("ReturnsErrorOnDoneContext", func(t *testing.T) {
+		mockDriver := new(drivertest.MockDriver)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		out := driver.WithContext(mockDriver).FieldResolveContext(ctx, driver.FieldResolveInput{})
+		assert.Equal(t, &driver.Error{Message: context.Canceled.Error()}, out.Error)
+		mockDriver.AssertNotCalled(t, "FieldResolve", driver.FieldResolveInput{})
+	})
+}
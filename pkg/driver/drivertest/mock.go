@@ -1,6 +1,8 @@
 package drivertest
 
 import (
+	"context"
+
 	"github.com/graphql-editor/stucco/pkg/driver"
 	"github.com/stretchr/testify/mock"
 )
@@ -59,3 +61,18 @@ func (m *MockDriver) SubscriptionListen(in driver.SubscriptionListenInput) drive
 func (m *MockDriver) SubscriptionConnection(in driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
 	return m.Called(in).Get(0).(driver.SubscriptionConnectionOutput)
 }
+
+// MockBatchDriver is a mock of driver implementing driver.BatchDriver
+type MockBatchDriver struct {
+	MockDriver
+}
+
+// FieldResolveBatch implements driver.BatchDriver
+func (m *MockBatchDriver) FieldResolveBatch(in driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	return m.Called(in).Get(0).(driver.FieldResolveBatchOutput)
+}
+
+// FieldResolveBatchContext implements driver.BatchDriver
+func (m *MockBatchDriver) FieldResolveBatchContext(ctx context.Context, in driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	return m.FieldResolveBatch(in)
+}
@@ -0,0 +1,67 @@
+package drivertest
+
+import (
+	"sync"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+)
+
+// StreamReader is a driver.StreamReader returning messages from a channel
+type StreamReader struct {
+	// Messages are read until channel is closed
+	Messages chan driver.StreamMessage
+	// Err is returned by Error after channel is closed
+	Err error
+
+	once      sync.Once
+	closeOnce sync.Once
+	closed    chan struct{}
+	msg       driver.StreamMessage
+}
+
+// NewStreamReader returns reader of messages
+func NewStreamReader(messages ...driver.StreamMessage) *StreamReader {
+	ch := make(chan driver.StreamMessage, len(messages))
+	for _, msg := range messages {
+		ch <- msg
+	}
+	close(ch)
+	return &StreamReader{Messages: ch}
+}
+
+func (s *StreamReader) closedCh() chan struct{} {
+	s.once.Do(func() {
+		if s.closed == nil {
+			s.closed = make(chan struct{})
+		}
+	})
+	return s.closed
+}
+
+// Error implements driver.StreamReader
+func (s *StreamReader) Error() error {
+	return s.Err
+}
+
+// Next implements driver.StreamReader
+func (s *StreamReader) Next() bool {
+	select {
+	case msg, ok := <-s.Messages:
+		s.msg = msg
+		return ok
+	case <-s.closedCh():
+		return false
+	}
+}
+
+// Read implements driver.StreamReader
+func (s *StreamReader) Read() driver.StreamMessage {
+	return s.msg
+}
+
+// Close implements driver.StreamReader
+func (s *StreamReader) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closedCh())
+	})
+}
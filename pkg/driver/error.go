@@ -1,6 +1,26 @@
 package driver
 
+const (
+	// ErrorCodeUnavailable is set on error when function could not be reached
+	ErrorCodeUnavailable = "UNAVAILABLE"
+	// ErrorCodeTimeout is set on error when function did not finish in time
+	ErrorCodeTimeout = "TIMEOUT"
+	// ErrorCodeCircuitOpen is set on error when function was not called because it is unhealthy
+	ErrorCodeCircuitOpen = "CIRCUIT_OPEN"
+)
+
 // Error passed between runner and router
 type Error struct {
 	Message string `json:"message,omitempty"`
+	// Code is an optional error classification, returned to client as extensions.code
+	Code string `json:"code,omitempty"`
+	// Extensions are optional error details returned to client in error extensions
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+	// Path is an optional path of error relative to the field that returned it
+	Path []interface{} `json:"path,omitempty"`
+}
+
+// Error implements error, allowing runner functions to return structured errors
+func (e *Error) Error() string {
+	return e.Message
 }
@@ -21,6 +21,8 @@ type FieldResolveInput struct {
 	Info                FieldResolveInfo `json:"info"`
 	Protocol            interface{}      `json:"protocol,omitempty"`
 	SubscriptionPayload interface{}      `json:"subscriptionPayload,omitempty"`
+	// Claims returned by authorize function of a request
+	Claims interface{} `json:"claims,omitempty"`
 }
 
 // FieldResolveOutput is a result of a field resolution
@@ -28,3 +30,22 @@ type FieldResolveOutput struct {
 	Response interface{} `json:"response,omitempty"`
 	Error    *Error      `json:"error,omitempty"`
 }
+
+// ResponseObject is an object value resolved for selections of a field keyed by response
+// keys of selections, so that aliased selections of the same field can have different values.
+// Router resolves fields of response object by their response keys. A field or an item of
+// a list which value is an *Error resolves to that error, with path of error relative to it.
+type ResponseObject map[string]interface{}
+
+// FieldResolveBatchInput represents a list of field resolutions
+// sent to driver in one call
+type FieldResolveBatchInput struct {
+	Inputs []FieldResolveInput `json:"inputs"`
+}
+
+// FieldResolveBatchOutput is a result of batch field resolution.
+// Outputs must be in the same order as inputs.
+type FieldResolveBatchOutput struct {
+	Outputs []FieldResolveOutput `json:"outputs,omitempty"`
+	Error   *Error               `json:"error,omitempty"`
+}
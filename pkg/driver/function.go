@@ -0,0 +1,47 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FunctionConfigurer is implemented by drivers which functions are described
+// by user config instead of code, for example endpoints called by a function.
+type FunctionConfigurer interface {
+	// AddFunction adds a function with a name described by provider specific config
+	AddFunction(name string, config interface{}) error
+}
+
+// DecodeFunctionConfig decodes function config loaded from json or yaml into v
+func DecodeFunctionConfig(config interface{}, v interface{}) error {
+	b, err := json.Marshal(jsonValue(config))
+	if err == nil {
+		err = json.Unmarshal(b, v)
+	}
+	return err
+}
+
+// jsonValue converts maps decoded from yaml to map[string]interface{}
+func jsonValue(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(vv))
+		for k, e := range vv {
+			m[fmt.Sprint(k)] = jsonValue(e)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(vv))
+		for k, e := range vv {
+			m[k] = jsonValue(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(vv))
+		for i, e := range vv {
+			l[i] = jsonValue(e)
+		}
+		return l
+	}
+	return v
+}
@@ -0,0 +1,248 @@
+/*
+Package graphqldriver implements a driver that forwards fields to remote GraphQL endpoints.
+
+Driver rebuilds a query of a remote field from selections of resolved field in
+operation, forwarding variables used by them. Objects of response are returned as
+driver.ResponseObject keyed by aliases of selections. Errors returned by remote endpoint
+are set in response data on their paths relative to resolved field, so fields that
+did not fail keep their values.
+*/
+package graphqldriver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/httpdriver"
+	"github.com/graphql-editor/stucco/pkg/utils"
+)
+
+// Provider is a name of environment provider handled by graphql driver
+const Provider = "graphql"
+
+func init() {
+	driver.RegisterFactory(Provider, func(driver.Config) driver.Driver {
+		return &Driver{}
+	})
+}
+
+// Request describes a remote GraphQL field resolving a field
+type Request struct {
+	// URL of remote GraphQL endpoint
+	URL string `json:"url"`
+	// Headers are templates of request headers, for example Bearer {secrets.TOKEN}
+	Headers map[string]string `json:"headers,omitempty"`
+	// Field is a name of remote root field, defaults to name of resolved field
+	Field string `json:"field,omitempty"`
+	// Arguments are additional arguments of remote field with paths of their
+	// values, for example source.id
+	Arguments map[string]string `json:"arguments,omitempty"`
+	// MergeTypes adds types of remote schema missing in local schema
+	MergeTypes bool `json:"mergeTypes,omitempty"`
+}
+
+type function struct {
+	url        string
+	headers    map[string]httpdriver.Template
+	field      string
+	arguments  map[string]utils.ValuePath
+	mergeTypes bool
+}
+
+// Driver resolves fields with remote GraphQL endpoints
+type Driver struct {
+	driver.Unsupported
+	// Client used for requests, defaults to http.DefaultClient
+	Client *http.Client
+
+	lock        sync.RWMutex
+	functions   map[string]function
+	secrets     driver.Secrets
+	remoteTypes map[string]map[string]string
+}
+
+type response struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []responseError        `json:"errors"`
+}
+
+type responseError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path"`
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+func parseHeaders(headers map[string]string) (map[string]httpdriver.Template, error) {
+	parsed := make(map[string]httpdriver.Template, len(headers))
+	for k, v := range headers {
+		t, err := httpdriver.ParseTemplate(v)
+		if err != nil {
+			return nil, err
+		}
+		parsed[k] = t
+	}
+	return parsed, nil
+}
+
+// Add adds a remote field called when function is called
+func (d *Driver) Add(name string, r Request) error {
+	fn := function{
+		url:        r.URL,
+		field:      r.Field,
+		arguments:  make(map[string]utils.ValuePath, len(r.Arguments)),
+		mergeTypes: r.MergeTypes,
+	}
+	var err error
+	if fn.headers, err = parseHeaders(r.Headers); err != nil {
+		return err
+	}
+	for k, v := range r.Arguments {
+		if fn.arguments[k], err = utils.ParseValuePath(v); err != nil {
+			return err
+		}
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.functions == nil {
+		d.functions = make(map[string]function)
+	}
+	d.functions[name] = fn
+	return nil
+}
+
+// AddFunction implements driver.FunctionConfigurer, config is decoded into a Request
+func (d *Driver) AddFunction(name string, config interface{}) error {
+	var r Request
+	if err := driver.DecodeFunctionConfig(config, &r); err != nil {
+		return err
+	}
+	return d.Add(name, r)
+}
+
+func (d *Driver) function(name string) (function, bool) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	fn, ok := d.functions[name]
+	return fn, ok
+}
+
+func (d *Driver) client() *http.Client {
+	if d.Client != nil {
+		return d.Client
+	}
+	return http.DefaultClient
+}
+
+// SetSecrets implements driver.Driver, secrets are available in header templates as {secrets.NAME}
+func (d *Driver) SetSecrets(in driver.SetSecretsInput) driver.SetSecretsOutput {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.secrets == nil {
+		d.secrets = make(driver.Secrets, len(in.Secrets))
+	}
+	for k, v := range in.Secrets {
+		d.secrets[k] = v
+	}
+	return driver.SetSecretsOutput{}
+}
+
+func (d *Driver) values(in driver.FieldResolveInput) map[string]interface{} {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return map[string]interface{}{
+		"args":    map[string]interface{}(in.Arguments),
+		"source":  in.Source,
+		"secrets": d.secrets,
+	}
+}
+
+// FieldResolve implements driver.Driver
+func (d *Driver) FieldResolve(in driver.FieldResolveInput) driver.FieldResolveOutput {
+	return d.FieldResolveContext(context.Background(), in)
+}
+
+// FieldResolveContext implements driver.ContextDriver
+func (d *Driver) FieldResolveContext(ctx context.Context, in driver.FieldResolveInput) driver.FieldResolveOutput {
+	fn, ok := d.function(in.Function.Name)
+	if !ok {
+		return driver.FieldResolveOutput{Error: &driver.Error{
+			Message: fmt.Sprintf("graphql field for function %s is not defined", in.Function.Name),
+		}}
+	}
+	values := d.values(in)
+	args := make(map[string]interface{}, len(fn.arguments))
+	for k, p := range fn.arguments {
+		args[k] = p.Get(values)
+	}
+	q, err := buildQuery(in, fn.field, args)
+	if err != nil {
+		return driver.FieldResolveOutput{Error: &driver.Error{Message: err.Error()}}
+	}
+	var resp response
+	if err := d.do(ctx, fn.url, fn.headers, values, q.query, q.variables, &resp); err != nil {
+		return driver.FieldResolveOutput{Error: err}
+	}
+	data := responseValue(resp.Data[q.key], q.selections)
+	var errs []*driver.Error
+	for _, e := range resp.Errors {
+		if err := placeError(data, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return driver.FieldResolveOutput{Error: joinErrors(errs)}
+	}
+	return driver.FieldResolveOutput{Response: data}
+}
+
+func (d *Driver) do(
+	ctx context.Context,
+	url string,
+	headers map[string]httpdriver.Template,
+	values map[string]interface{},
+	query string,
+	variables map[string]interface{},
+	v interface{},
+) *driver.Error {
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return &driver.Error{Message: err.Error()}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &driver.Error{Message: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	for k, h := range headers {
+		req.Header.Set(k, h.Execute(values))
+	}
+	resp, err := d.client().Do(req)
+	if err != nil {
+		return &driver.Error{Message: err.Error()}
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return &driver.Error{Message: err.Error()}
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return &driver.Error{
+				Message:    fmt.Sprintf("unexpected status %d", resp.StatusCode),
+				Extensions: map[string]interface{}{"status": resp.StatusCode},
+			}
+		}
+		return &driver.Error{Message: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package graphqldriver_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/graphqldriver"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldResolve(t *testing.T) {
+	var query string
+	var variables map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		query, variables = body.Query, body.Variables
+		rw.Write([]byte(`{"data": {"author": {"fullName": "John"}}}`))
+	}))
+	defer srv.Close()
+	var d graphqldriver.Driver
+	require.NoError(t, d.Add("author", graphqldriver.Request{
+		URL:       srv.URL,
+		Field:     "user",
+		Arguments: map[string]string{"id": "source.authorId"},
+	}))
+	out := d.FieldResolve(driver.FieldResolveInput{
+		Function: types.Function{Name: "author"},
+		Source:   map[string]interface{}{"authorId": "1"},
+		Info: driver.FieldResolveInfo{
+			FieldName: "author",
+			Path: &types.ResponsePath{
+				Prev: &types.ResponsePath{
+					Prev: &types.ResponsePath{Key: "posts"},
+					Key:  0,
+				},
+				Key: "author",
+			},
+			VariableValues: map[string]interface{}{"upper": true, "unused": 1},
+			Operation: &types.OperationDefinition{
+				Operation: "query",
+				VariableDefinitions: []types.VariableDefinition{
+					{Variable: types.Variable{Name: "upper"}, Type: &types.TypeRef{Name: "Boolean"}},
+					{Variable: types.Variable{Name: "unused"}, Type: &types.TypeRef{Name: "Int"}},
+				},
+				SelectionSet: types.Selections{{
+					Name: "posts",
+					SelectionSet: types.Selections{{
+						Definition: &types.FragmentDefinition{
+							TypeCondition: types.TypeRef{Name: "Post"},
+							SelectionSet: types.Selections{{
+								Name: "author",
+								SelectionSet: types.Selections{{
+									Name:      "name",
+									Alias:     "fullName",
+									Arguments: types.Arguments{"upper": ast.NewVariable(&ast.Variable{Name: ast.NewName(&ast.Name{Value: "upper"})})},
+								}},
+							}},
+						},
+					}},
+				}},
+			},
+		},
+	})
+	require.Nil(t, out.Error)
+	assert.Equal(t, driver.ResponseObject{"fullName": "John"}, out.Response)
+	assert.Equal(t, `query($upper: Boolean) { author: user(id: "1") { __typename fullName: name(upper: $upper) } }`, query)
+	assert.Equal(t, map[string]interface{}{"upper": true}, variables)
+
+	out = d.FieldResolve(driver.FieldResolveInput{
+		Function: types.Function{Name: "author"},
+		Source:   map[string]interface{}{"authorId": "1"},
+		Info: driver.FieldResolveInfo{
+			FieldName:      "author",
+			Path:           &types.ResponsePath{Key: "author"},
+			VariableValues: map[string]interface{}{"full": true},
+			Operation: &types.OperationDefinition{
+				Operation: "query",
+				VariableDefinitions: []types.VariableDefinition{
+					{Variable: types.Variable{Name: "full"}, Type: &types.TypeRef{NonNull: &types.TypeRef{Name: "Boolean"}}},
+				},
+				SelectionSet: types.Selections{{
+					Name: "author",
+					SelectionSet: types.Selections{{
+						Directives: types.Directives{{
+							Name:      "include",
+							Arguments: types.Arguments{"if": ast.NewVariable(&ast.Variable{Name: ast.NewName(&ast.Name{Value: "full"})})},
+						}},
+						Definition: &types.FragmentDefinition{
+							SelectionSet: types.Selections{{Name: "fullName"}},
+						},
+					}},
+				}},
+			},
+		},
+	})
+	require.Nil(t, out.Error)
+	assert.Equal(t, `query($full: Boolean!) { author: user(id: "1") { __typename ... @include(if: $full) { __typename fullName } } }`, query)
+	assert.Equal(t, map[string]interface{}{"full": true}, variables)
+
+	out = d.FieldResolve(driver.FieldResolveInput{Function: types.Function{Name: "undefined"}})
+	assert.NotNil(t, out.Error)
+}
+
+func TestFieldResolvePartialErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{
+			"data": {"author": {"name": null, "posts": [{"title": "First"}, null]}},
+			"errors": [
+				{"message": "name failed", "path": ["author", "name"], "extensions": {"code": "NAME"}},
+				{"message": "post failed", "path": ["author", "posts", 1, "title"]},
+				{"message": "name failed again", "path": ["author", "name"]}
+			]
+		}`))
+	}))
+	defer srv.Close()
+	var d graphqldriver.Driver
+	require.NoError(t, d.Add("author", graphqldriver.Request{URL: srv.URL}))
+	in := driver.FieldResolveInput{
+		Function: types.Function{Name: "author"},
+		Info: driver.FieldResolveInfo{
+			FieldName: "author",
+			Path:      &types.ResponsePath{Key: "author"},
+			Operation: &types.OperationDefinition{
+				Operation: "query",
+				SelectionSet: types.Selections{{
+					Name: "author",
+					SelectionSet: types.Selections{
+						{Name: "name"},
+						{Name: "posts", SelectionSet: types.Selections{{Name: "title"}}},
+					},
+				}},
+			},
+		},
+	}
+	out := d.FieldResolve(in)
+	require.Nil(t, out.Error)
+	assert.Equal(t, driver.ResponseObject{
+		"name": &driver.Error{
+			Message: "name failed",
+			Extensions: map[string]interface{}{
+				"code": "NAME",
+				"errors": []interface{}{
+					map[string]interface{}{"message": "name failed again"},
+				},
+			},
+		},
+		"posts": []interface{}{
+			driver.ResponseObject{"title": "First"},
+			&driver.Error{
+				Message: "post failed",
+				Path:    []interface{}{"title"},
+			},
+		},
+	}, out.Response)
+}
@@ -0,0 +1,228 @@
+package graphqldriver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/printer"
+)
+
+// query is a remote operation forwarding selections of a field
+type query struct {
+	// key of remote field in response data
+	key        string
+	query      string
+	variables  map[string]interface{}
+	selections types.Selections
+}
+
+type queryBuilder struct {
+	b         strings.Builder
+	variables map[string]struct{}
+}
+
+// buildQuery builds a query of remote field with selections of a resolved field
+func buildQuery(in driver.FieldResolveInput, field string, extraArgs map[string]interface{}) (query, error) {
+	op := in.Info.Operation
+	if op == nil {
+		return query{}, errors.New("field resolve input does not have an operation")
+	}
+	var keys []string
+	for p := in.Info.Path; p != nil; p = p.Prev {
+		if k, ok := p.Key.(string); ok {
+			keys = append([]string{k}, keys...)
+		}
+	}
+	if len(keys) == 0 {
+		return query{}, errors.New("field resolve input does not have a path")
+	}
+	selections := op.SelectionSet
+	var fields []types.Selection
+	for _, k := range keys {
+		fields = collectFields(selections, k)
+		selections = nil
+		for _, f := range fields {
+			selections = append(selections, f.SelectionSet...)
+		}
+	}
+	if len(fields) == 0 {
+		return query{}, fmt.Errorf("selection of %s not found in operation", strings.Join(keys, "."))
+	}
+	if field == "" {
+		field = fields[0].Name
+	}
+	key := keys[len(keys)-1]
+	qb := queryBuilder{variables: make(map[string]struct{})}
+	qb.b.WriteString(key + ": " + field)
+	args := make(map[string]interface{}, len(fields[0].Arguments)+len(extraArgs))
+	for k, v := range fields[0].Arguments {
+		args[k] = v
+	}
+	for k, v := range extraArgs {
+		args[k] = v
+	}
+	qb.arguments(args)
+	qb.selectionSet(selections)
+	operation := "query"
+	if op.Operation == "mutation" && len(keys) == 1 {
+		operation = "mutation"
+	}
+	var b strings.Builder
+	b.WriteString(operation)
+	q := query{key: key, selections: selections}
+	if len(qb.variables) > 0 {
+		definitions := make([]string, 0, len(qb.variables))
+		q.variables = make(map[string]interface{}, len(qb.variables))
+		for _, def := range op.VariableDefinitions {
+			name := def.Variable.Name
+			if _, ok := qb.variables[name]; !ok || def.Type == nil {
+				continue
+			}
+			definitions = append(definitions, "$"+name+": "+typeString(*def.Type))
+			if v, ok := in.Info.VariableValues[name]; ok {
+				q.variables[name] = v
+			}
+		}
+		b.WriteString("(" + strings.Join(definitions, ", ") + ")")
+	}
+	b.WriteString(" { " + qb.b.String() + " }")
+	q.query = b.String()
+	return q, nil
+}
+
+// collectFields returns fields with response key, fields of fragments are included
+func collectFields(selections types.Selections, key string) (fields []types.Selection) {
+	for _, s := range selections {
+		if s.Definition != nil {
+			fields = append(fields, collectFields(s.Definition.SelectionSet, key)...)
+			continue
+		}
+		responseKey := s.Alias
+		if responseKey == "" {
+			responseKey = s.Name
+		}
+		if responseKey == key {
+			fields = append(fields, s)
+		}
+	}
+	return
+}
+
+// selectionSet writes selections with their aliases, so that response objects are keyed
+// by response keys, with __typename added for resolution of abstract types
+func (qb *queryBuilder) selectionSet(selections types.Selections) {
+	if len(selections) == 0 {
+		return
+	}
+	qb.b.WriteString(" { __typename")
+	for _, s := range selections {
+		qb.b.WriteString(" ")
+		if s.Definition != nil {
+			qb.b.WriteString("...")
+			if typeCondition := typeString(s.Definition.TypeCondition); typeCondition != "" {
+				qb.b.WriteString(" on " + typeCondition)
+			}
+			qb.directives(s.Directives)
+			qb.selectionSet(s.Definition.SelectionSet)
+			continue
+		}
+		if s.Alias != "" && s.Alias != s.Name {
+			qb.b.WriteString(s.Alias + ": ")
+		}
+		qb.b.WriteString(s.Name)
+		qb.arguments(s.Arguments)
+		qb.directives(s.Directives)
+		qb.selectionSet(s.SelectionSet)
+	}
+	qb.b.WriteString(" }")
+}
+
+func (qb *queryBuilder) directives(directives types.Directives) {
+	for _, d := range directives {
+		qb.b.WriteString(" @" + d.Name)
+		qb.arguments(d.Arguments)
+	}
+}
+
+func (qb *queryBuilder) arguments(args map[string]interface{}) {
+	if len(args) == 0 {
+		return
+	}
+	names := make([]string, 0, len(args))
+	for k := range args {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	qb.b.WriteString("(")
+	for i, k := range names {
+		if i > 0 {
+			qb.b.WriteString(", ")
+		}
+		qb.b.WriteString(k + ": " + qb.value(args[k]))
+	}
+	qb.b.WriteString(")")
+}
+
+// value returns GraphQL literal of value. AST values of operation are printed as is
+// with variables they use recorded.
+func (qb *queryBuilder) value(v interface{}) string {
+	switch vv := v.(type) {
+	case ast.Value:
+		qb.recordVariables(vv)
+		s, _ := printer.Print(vv).(string)
+		return s
+	case []interface{}:
+		values := make([]string, 0, len(vv))
+		for _, e := range vv {
+			values = append(values, qb.value(e))
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(vv))
+		for k := range vv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fields := make([]string, 0, len(vv))
+		for _, k := range keys {
+			fields = append(fields, k+": "+qb.value(vv[k]))
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "null"
+	}
+	return string(b)
+}
+
+func (qb *queryBuilder) recordVariables(v ast.Value) {
+	switch vv := v.(type) {
+	case *ast.Variable:
+		qb.variables[vv.Name.Value] = struct{}{}
+	case *ast.ListValue:
+		for _, e := range vv.Values {
+			qb.recordVariables(e)
+		}
+	case *ast.ObjectValue:
+		for _, f := range vv.Fields {
+			qb.recordVariables(f.Value)
+		}
+	}
+}
+
+func typeString(t types.TypeRef) string {
+	switch {
+	case t.NonNull != nil:
+		return typeString(*t.NonNull) + "!"
+	case t.List != nil:
+		return "[" + typeString(*t.List) + "]"
+	}
+	return t.Name
+}
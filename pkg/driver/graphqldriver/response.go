@@ -0,0 +1,126 @@
+package graphqldriver
+
+import (
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+)
+
+// responseValue converts objects of remote response with selections to driver.ResponseObject,
+// so that router resolves their fields by response keys
+func responseValue(v interface{}, selections types.Selections) interface{} {
+	if len(selections) == 0 {
+		return v
+	}
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		obj := make(driver.ResponseObject, len(vv))
+		for k, fv := range vv {
+			var sub types.Selections
+			for _, f := range collectFields(selections, k) {
+				sub = append(sub, f.SelectionSet...)
+			}
+			obj[k] = responseValue(fv, sub)
+		}
+		return obj
+	case []interface{}:
+		for i, lv := range vv {
+			vv[i] = responseValue(lv, selections)
+		}
+	}
+	return v
+}
+
+// placeError sets remote error in data at the deepest field or list item on its path
+// which can hold it, so that router reports it there while keeping the rest of data.
+// Remaining part of path is kept in error. Error that can not be placed in data is returned.
+func placeError(data interface{}, e responseError) *driver.Error {
+	err := &driver.Error{
+		Message:    e.Message,
+		Extensions: e.Extensions,
+	}
+	if len(e.Path) < 2 {
+		return err
+	}
+	// path of remote error starts with key of resolved field
+	path := e.Path[1:]
+	if !placeErrorAt(data, path, err) {
+		err.Path = relativePath(path)
+		return err
+	}
+	return nil
+}
+
+func placeErrorAt(v interface{}, path []interface{}, err *driver.Error) bool {
+	if len(path) == 0 {
+		return false
+	}
+	var set func(interface{})
+	var next interface{}
+	switch p := path[0].(type) {
+	case string:
+		o, ok := v.(driver.ResponseObject)
+		if !ok {
+			return false
+		}
+		next = o[p]
+		set = func(v interface{}) { o[p] = v }
+	case float64:
+		l, ok := v.([]interface{})
+		if !ok || int(p) < 0 || int(p) >= len(l) {
+			return false
+		}
+		next = l[int(p)]
+		set = func(v interface{}) { l[int(p)] = v }
+	default:
+		return false
+	}
+	if placeErrorAt(next, path[1:], err) {
+		return true
+	}
+	err.Path = relativePath(path[1:])
+	if placed, ok := next.(*driver.Error); ok {
+		appendError(placed, err)
+	} else {
+		set(err)
+	}
+	return true
+}
+
+// relativePath returns path of error with indices of lists decoded as ints
+func relativePath(path []interface{}) []interface{} {
+	if len(path) == 0 {
+		return nil
+	}
+	rel := make([]interface{}, len(path))
+	for i, p := range path {
+		if f, ok := p.(float64); ok {
+			p = int(f)
+		}
+		rel[i] = p
+	}
+	return rel
+}
+
+// appendError adds error to errors extension of another error reported at the same field
+func appendError(to, err *driver.Error) {
+	if to.Extensions == nil {
+		to.Extensions = make(map[string]interface{})
+	}
+	errs, _ := to.Extensions["errors"].([]interface{})
+	remote := map[string]interface{}{"message": err.Message}
+	if len(err.Path) > 0 {
+		remote["path"] = err.Path
+	}
+	if len(err.Extensions) > 0 {
+		remote["extensions"] = err.Extensions
+	}
+	to.Extensions["errors"] = append(errs, remote)
+}
+
+// joinErrors returns first error with remaining errors in its errors extension
+func joinErrors(errs []*driver.Error) *driver.Error {
+	for _, err := range errs[1:] {
+		appendError(errs[0], err)
+	}
+	return errs[0]
+}
@@ -0,0 +1,207 @@
+package graphqldriver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const introspectionQuery = `query {
+	__schema {
+		queryType { name }
+		mutationType { name }
+		subscriptionType { name }
+		types {
+			kind
+			name
+			fields(includeDeprecated: true) {
+				name
+				args { name type { ...TypeRef } defaultValue }
+				type { ...TypeRef }
+			}
+			inputFields { name type { ...TypeRef } defaultValue }
+			interfaces { name }
+			enumValues(includeDeprecated: true) { name }
+			possibleTypes { name }
+		}
+	}
+}
+fragment TypeRef on __Type {
+	kind name ofType { kind name ofType { kind name ofType { kind name ofType {
+		kind name ofType { kind name ofType { kind name ofType { kind name } } }
+	} } } }
+}`
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   string                `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+type introspectionInputValue struct {
+	Name         string               `json:"name"`
+	Type         introspectionTypeRef `json:"type"`
+	DefaultValue *string              `json:"defaultValue"`
+}
+
+type introspectionField struct {
+	Name string                    `json:"name"`
+	Args []introspectionInputValue `json:"args"`
+	Type introspectionTypeRef      `json:"type"`
+}
+
+type introspectionNamed struct {
+	Name string `json:"name"`
+}
+
+type introspectionType struct {
+	Kind          string                    `json:"kind"`
+	Name          string                    `json:"name"`
+	Fields        []introspectionField      `json:"fields"`
+	InputFields   []introspectionInputValue `json:"inputFields"`
+	Interfaces    []introspectionNamed      `json:"interfaces"`
+	EnumValues    []introspectionNamed      `json:"enumValues"`
+	PossibleTypes []introspectionNamed      `json:"possibleTypes"`
+}
+
+type introspectionResponse struct {
+	Data struct {
+		Schema struct {
+			QueryType        *introspectionNamed `json:"queryType"`
+			MutationType     *introspectionNamed `json:"mutationType"`
+			SubscriptionType *introspectionNamed `json:"subscriptionType"`
+			Types            []introspectionType `json:"types"`
+		} `json:"__schema"`
+	} `json:"data"`
+	Errors []responseError `json:"errors"`
+}
+
+var builtinScalars = map[string]bool{
+	"String":  true,
+	"Int":     true,
+	"Float":   true,
+	"Boolean": true,
+	"ID":      true,
+}
+
+// RemoteTypes returns definitions of types in schema of remote endpoint of function keyed
+// by type name, if function merges types. Root operation types, built in scalars and
+// introspection types are omitted. Schema of each endpoint is fetched once.
+func (d *Driver) RemoteTypes(ctx context.Context, name string) (map[string]string, error) {
+	fn, ok := d.function(name)
+	if !ok {
+		return nil, fmt.Errorf("function %s not found", name)
+	}
+	if !fn.mergeTypes {
+		return nil, nil
+	}
+	d.lock.RLock()
+	definitions, ok := d.remoteTypes[fn.url]
+	values := map[string]interface{}{"secrets": d.secrets}
+	d.lock.RUnlock()
+	if ok {
+		return definitions, nil
+	}
+	var resp introspectionResponse
+	if err := d.do(ctx, fn.url, fn.headers, values, introspectionQuery, nil, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Errors) > 0 {
+		return nil, errors.New(resp.Errors[0].Message)
+	}
+	schema := resp.Data.Schema
+	skip := map[string]bool{}
+	for _, root := range []*introspectionNamed{schema.QueryType, schema.MutationType, schema.SubscriptionType} {
+		if root != nil {
+			skip[root.Name] = true
+		}
+	}
+	definitions = make(map[string]string, len(schema.Types))
+	for _, t := range schema.Types {
+		if skip[t.Name] || builtinScalars[t.Name] || strings.HasPrefix(t.Name, "__") {
+			continue
+		}
+		if def := typeDefinition(t); def != "" {
+			definitions[t.Name] = def
+		}
+	}
+	d.lock.Lock()
+	if d.remoteTypes == nil {
+		d.remoteTypes = make(map[string]map[string]string)
+	}
+	d.remoteTypes[fn.url] = definitions
+	d.lock.Unlock()
+	return definitions, nil
+}
+
+func typeDefinition(t introspectionType) string {
+	var b strings.Builder
+	switch t.Kind {
+	case "SCALAR":
+		b.WriteString("scalar " + t.Name)
+	case "OBJECT", "INTERFACE":
+		if t.Kind == "OBJECT" {
+			b.WriteString("type " + t.Name)
+		} else {
+			b.WriteString("interface " + t.Name)
+		}
+		if len(t.Interfaces) > 0 {
+			b.WriteString(" implements " + joinNames(t.Interfaces, " & "))
+		}
+		b.WriteString(" {\n")
+		for _, f := range t.Fields {
+			b.WriteString("\t" + f.Name)
+			if len(f.Args) > 0 {
+				args := make([]string, 0, len(f.Args))
+				for _, a := range f.Args {
+					args = append(args, inputValue(a))
+				}
+				b.WriteString("(" + strings.Join(args, ", ") + ")")
+			}
+			b.WriteString(": " + typeRef(f.Type) + "\n")
+		}
+		b.WriteString("}")
+	case "UNION":
+		b.WriteString("union " + t.Name + " = " + joinNames(t.PossibleTypes, " | "))
+	case "ENUM":
+		b.WriteString("enum " + t.Name + " {\n")
+		for _, v := range t.EnumValues {
+			b.WriteString("\t" + v.Name + "\n")
+		}
+		b.WriteString("}")
+	case "INPUT_OBJECT":
+		b.WriteString("input " + t.Name + " {\n")
+		for _, f := range t.InputFields {
+			b.WriteString("\t" + inputValue(f) + "\n")
+		}
+		b.WriteString("}")
+	}
+	return b.String()
+}
+
+func inputValue(v introspectionInputValue) string {
+	s := v.Name + ": " + typeRef(v.Type)
+	if v.DefaultValue != nil {
+		s += " = " + *v.DefaultValue
+	}
+	return s
+}
+
+func typeRef(t introspectionTypeRef) string {
+	switch {
+	case t.Kind == "NON_NULL" && t.OfType != nil:
+		return typeRef(*t.OfType) + "!"
+	case t.Kind == "LIST" && t.OfType != nil:
+		return "[" + typeRef(*t.OfType) + "]"
+	}
+	return t.Name
+}
+
+func joinNames(names []introspectionNamed, sep string) string {
+	s := make([]string, 0, len(names))
+	for _, n := range names {
+		s = append(s, n.Name)
+	}
+	return strings.Join(s, sep)
+}
@@ -0,0 +1,227 @@
+/*
+Package httpdriver implements a driver that resolves fields by calling REST endpoints.
+
+Each function handled by driver is described by a Request. URL, headers and body
+of a request are templates in which placeholders in form of {args.path},
+{source.path} and {secrets.NAME} are replaced with values of field arguments,
+field source and secrets set on driver. Placeholders in body are replaced with
+JSON encoded values.
+*/
+package httpdriver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/utils"
+)
+
+// Provider is a name of environment provider handled by http driver
+const Provider = "http"
+
+const maxErrorBody = 512
+
+func init() {
+	driver.RegisterFactory(Provider, func(driver.Config) driver.Driver {
+		return &Driver{}
+	})
+}
+
+// Request describes an http call made to resolve a field
+type Request struct {
+	// URL template of endpoint, for example https://api.example.com/users/{args.id}
+	URL string `json:"url"`
+	// Method of request, defaults to GET or POST if request has a body
+	Method string `json:"method,omitempty"`
+	// Headers are templates of request headers, for example Bearer {secrets.TOKEN}
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body template of request
+	Body string `json:"body,omitempty"`
+	// ResponsePath selects value returned by field from JSON response, for example data.items
+	ResponsePath string `json:"responsePath,omitempty"`
+}
+
+type function struct {
+	method       string
+	url          Template
+	headers      map[string]Template
+	body         *Template
+	responsePath utils.ValuePath
+}
+
+// Driver resolves fields by calling REST endpoints
+type Driver struct {
+	driver.Unsupported
+	// Client used for requests, defaults to http.DefaultClient
+	Client *http.Client
+
+	lock      sync.RWMutex
+	functions map[string]function
+	secrets   driver.Secrets
+}
+
+// Add adds a request made when function is called
+func (d *Driver) Add(name string, r Request) error {
+	fn := function{
+		method:  r.Method,
+		headers: make(map[string]Template, len(r.Headers)),
+	}
+	var err error
+	if fn.url, err = ParseTemplate(r.URL); err != nil {
+		return err
+	}
+	for k, v := range r.Headers {
+		if fn.headers[k], err = ParseTemplate(v); err != nil {
+			return err
+		}
+	}
+	if r.Body != "" {
+		body, err := ParseTemplate(r.Body)
+		if err != nil {
+			return err
+		}
+		fn.body = &body
+	}
+	if r.ResponsePath != "" {
+		if fn.responsePath, err = utils.ParseValuePath(r.ResponsePath); err != nil {
+			return err
+		}
+	}
+	if fn.method == "" {
+		fn.method = http.MethodGet
+		if fn.body != nil {
+			fn.method = http.MethodPost
+		}
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.functions == nil {
+		d.functions = make(map[string]function)
+	}
+	d.functions[name] = fn
+	return nil
+}
+
+// AddFunction implements driver.FunctionConfigurer, config is decoded into a Request
+func (d *Driver) AddFunction(name string, config interface{}) error {
+	var r Request
+	if err := driver.DecodeFunctionConfig(config, &r); err != nil {
+		return err
+	}
+	return d.Add(name, r)
+}
+
+func (d *Driver) function(name string) (function, bool) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	fn, ok := d.functions[name]
+	return fn, ok
+}
+
+func (d *Driver) client() *http.Client {
+	if d.Client != nil {
+		return d.Client
+	}
+	return http.DefaultClient
+}
+
+// SetSecrets implements driver.Driver, secrets are available in templates as {secrets.NAME}
+func (d *Driver) SetSecrets(in driver.SetSecretsInput) driver.SetSecretsOutput {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.secrets == nil {
+		d.secrets = make(driver.Secrets, len(in.Secrets))
+	}
+	for k, v := range in.Secrets {
+		d.secrets[k] = v
+	}
+	return driver.SetSecretsOutput{}
+}
+
+func (d *Driver) values(in driver.FieldResolveInput) map[string]interface{} {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return map[string]interface{}{
+		"args":    map[string]interface{}(in.Arguments),
+		"source":  in.Source,
+		"secrets": d.secrets,
+	}
+}
+
+// FieldResolve implements driver.Driver
+func (d *Driver) FieldResolve(in driver.FieldResolveInput) driver.FieldResolveOutput {
+	return d.FieldResolveContext(context.Background(), in)
+}
+
+// FieldResolveContext implements driver.ContextDriver
+func (d *Driver) FieldResolveContext(ctx context.Context, in driver.FieldResolveInput) driver.FieldResolveOutput {
+	fn, ok := d.function(in.Function.Name)
+	if !ok {
+		return driver.FieldResolveOutput{Error: &driver.Error{
+			Message: fmt.Sprintf("http request for function %s is not defined", in.Function.Name),
+		}}
+	}
+	resp, err := d.do(ctx, fn, d.values(in))
+	if err != nil {
+		return driver.FieldResolveOutput{Error: err}
+	}
+	if fn.responsePath != nil {
+		resp = fn.responsePath.Get(resp)
+	}
+	return driver.FieldResolveOutput{Response: resp}
+}
+
+func (d *Driver) do(ctx context.Context, fn function, values map[string]interface{}) (interface{}, *driver.Error) {
+	var body bytes.Buffer
+	if fn.body != nil {
+		body.WriteString(fn.body.execute(values, jsonValue))
+	}
+	req, err := http.NewRequestWithContext(ctx, fn.method, fn.url.executeURL(values), &body)
+	if err != nil {
+		return nil, &driver.Error{Message: err.Error()}
+	}
+	if fn.body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, h := range fn.headers {
+		req.Header.Set(k, h.Execute(values))
+	}
+	resp, err := d.client().Do(req)
+	if err != nil {
+		return nil, &driver.Error{Message: err.Error()}
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &driver.Error{Message: err.Error()}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg := strings.TrimSpace(string(b))
+		if len(msg) > maxErrorBody {
+			msg = msg[:maxErrorBody]
+		}
+		return nil, &driver.Error{
+			Message:    fmt.Sprintf("unexpected status %d: %s", resp.StatusCode, msg),
+			Extensions: map[string]interface{}{"status": resp.StatusCode},
+		}
+	}
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		if strings.Contains(resp.Header.Get("Content-Type"), "json") {
+			return nil, &driver.Error{Message: err.Error()}
+		}
+		return string(b), nil
+	}
+	return v, nil
+}
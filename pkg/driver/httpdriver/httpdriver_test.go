@@ -0,0 +1,82 @@
+package httpdriver_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/httpdriver"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldResolve(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/a b":
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			assert.Equal(t, "x&y", r.URL.Query().Get("org"))
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{"data": {"user": {"id": "a b", "name": "John"}}}`))
+		case "/users":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			b, _ := ioutil.ReadAll(r.Body)
+			var body map[string]interface{}
+			require.NoError(t, json.Unmarshal(b, &body))
+			rw.Write(b)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte("not found"))
+		}
+	}))
+	defer srv.Close()
+	var d httpdriver.Driver
+	d.SetSecrets(driver.SetSecretsInput{Secrets: driver.Secrets{"TOKEN": "token"}})
+	require.NoError(t, d.Add("user", httpdriver.Request{
+		URL:          srv.URL + "/users/{args.id}?org={source.org}",
+		Headers:      map[string]string{"Authorization": "Bearer {secrets.TOKEN}"},
+		ResponsePath: "data.user",
+	}))
+	require.NoError(t, d.Add("createUser", httpdriver.Request{
+		URL:  srv.URL + "/users",
+		Body: `{"name": {args.input.name}, "age": {args.input.age}, "tags": {args.tags}}`,
+	}))
+	require.NoError(t, d.Add("missing", httpdriver.Request{URL: srv.URL + "/missing"}))
+
+	out := d.FieldResolve(driver.FieldResolveInput{
+		Function:  types.Function{Name: "user"},
+		Arguments: types.Arguments{"id": "a b"},
+		Source:    map[string]interface{}{"org": "x&y"},
+	})
+	require.Nil(t, out.Error)
+	assert.Equal(t, map[string]interface{}{"id": "a b", "name": "John"}, out.Response)
+
+	out = d.FieldResolve(driver.FieldResolveInput{
+		Function: types.Function{Name: "createUser"},
+		Arguments: types.Arguments{
+			"input": map[string]interface{}{"name": "John", "age": 30},
+			"tags":  []interface{}{"a"},
+		},
+	})
+	require.Nil(t, out.Error)
+	assert.Equal(t, map[string]interface{}{"name": "John", "age": float64(30), "tags": []interface{}{"a"}}, out.Response)
+
+	out = d.FieldResolve(driver.FieldResolveInput{Function: types.Function{Name: "missing"}})
+	require.NotNil(t, out.Error)
+	assert.Equal(t, "unexpected status 404: not found", out.Error.Message)
+	assert.Equal(t, map[string]interface{}{"status": http.StatusNotFound}, out.Error.Extensions)
+
+	out = d.FieldResolve(driver.FieldResolveInput{Function: types.Function{Name: "undefined"}})
+	assert.NotNil(t, out.Error)
+}
+
+func TestAddInvalidResponsePath(t *testing.T) {
+	var d httpdriver.Driver
+	assert.Error(t, d.Add("fn", httpdriver.Request{URL: "http://localhost", ResponsePath: "a[b]"}))
+}
@@ -0,0 +1,103 @@
+package httpdriver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-editor/stucco/pkg/utils"
+)
+
+var placeholder = regexp.MustCompile(`\{((?:args|source|secrets)(?:\.[A-Za-z0-9_\-]+|\[[0-9]+\])*)\}`)
+
+type templatePart struct {
+	text string
+	path utils.ValuePath
+}
+
+// Template is a text with placeholders of values in form of {args.path},
+// {source.path} and {secrets.NAME}
+type Template []templatePart
+
+// ParseTemplate parses placeholders in text
+func ParseTemplate(s string) (Template, error) {
+	var t Template
+	last := 0
+	for _, m := range placeholder.FindAllStringSubmatchIndex(s, -1) {
+		if m[0] > last {
+			t = append(t, templatePart{text: s[last:m[0]]})
+		}
+		path, err := utils.ParseValuePath(s[m[2]:m[3]])
+		if err != nil {
+			return nil, err
+		}
+		t = append(t, templatePart{path: path})
+		last = m[1]
+	}
+	if last < len(s) {
+		t = append(t, templatePart{text: s[last:]})
+	}
+	return t, nil
+}
+
+// Execute returns text with placeholders replaced with values
+func (t Template) Execute(values map[string]interface{}) string {
+	return t.execute(values, stringValue)
+}
+
+func (t Template) execute(values map[string]interface{}, format func(interface{}) string) string {
+	var b strings.Builder
+	for _, p := range t {
+		if p.path == nil {
+			b.WriteString(p.text)
+			continue
+		}
+		b.WriteString(format(p.path.Get(values)))
+	}
+	return b.String()
+}
+
+// executeURL executes template escaping values in path and query of URL
+func (t Template) executeURL(values map[string]interface{}) string {
+	var b strings.Builder
+	query := false
+	for _, p := range t {
+		if p.path == nil {
+			b.WriteString(p.text)
+			query = query || strings.Contains(p.text, "?")
+			continue
+		}
+		v := stringValue(p.path.Get(values))
+		if query {
+			b.WriteString(url.QueryEscape(v))
+		} else {
+			b.WriteString(url.PathEscape(v))
+		}
+	}
+	return b.String()
+}
+
+func stringValue(v interface{}) string {
+	switch vv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return vv
+	case float64:
+		return strconv.FormatFloat(vv, 'f', -1, 64)
+	case map[string]interface{}, []interface{}:
+		return jsonValue(v)
+	}
+	return fmt.Sprint(v)
+}
+
+func jsonValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "null"
+	}
+	return string(b)
+}
@@ -19,6 +19,8 @@ type InterfaceResolveTypeInput struct {
 	Function types.Function
 	Value    interface{}
 	Info     InterfaceResolveTypeInfo
+	// Claims returned by authorize function of a request
+	Claims interface{} `json:"claims,omitempty"`
 }
 
 // InterfaceResolveTypeOutput represents an output returned by runner for request of
@@ -0,0 +1,252 @@
+/*
+Package mock implements a driver that synthesizes values of fields from schema.
+
+Mock driver does not call any functions, values are generated from types of
+fields. Objects returned by mock driver contain only leaf fields, object fields
+of mocked values are resolved by the driver when they are resolved. Values are
+seeded by path of a field, so the same query returns the same data.
+*/
+package mock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+)
+
+// Provider is a name of environment provider handled by mock driver
+const Provider = "mock"
+
+const (
+	defaultListSize           = 3
+	defaultSubscriptionPeriod = time.Second
+)
+
+// Fixtures are values of fields keyed by Type.field that are returned instead of
+// generated ones. Value of an object field is merged with generated object.
+type Fixtures map[string]interface{}
+
+// Object is an object value created by mock driver
+type Object map[string]interface{}
+
+func init() {
+	driver.RegisterFactory(Provider, func(driver.Config) driver.Driver {
+		return &Driver{}
+	})
+}
+
+// Driver synthesizes values of fields from schema. Drivers returned from
+// registry for mock provider do not have a schema until their user sets one.
+type Driver struct {
+	// Schema from which values are generated
+	Schema *graphql.Schema
+	// Fixtures override generated values
+	Fixtures Fixtures
+	// Seed of generated values
+	Seed int64
+	// ListSize is a maximum size of generated lists, defaults to 3
+	ListSize int
+	// SubscriptionPeriod is a time between subscription events, defaults to 1 second
+	SubscriptionPeriod time.Duration
+}
+
+// Authorize implements driver.Driver, all requests are authorized
+func (d *Driver) Authorize(driver.AuthorizeInput) driver.AuthorizeOutput {
+	return driver.AuthorizeOutput{Response: true}
+}
+
+// SetSecrets implements driver.Driver
+func (d *Driver) SetSecrets(driver.SetSecretsInput) driver.SetSecretsOutput {
+	return driver.SetSecretsOutput{}
+}
+
+// FieldResolve implements driver.Driver
+func (d *Driver) FieldResolve(in driver.FieldResolveInput) driver.FieldResolveOutput {
+	parent := typeRefName(in.Info.ParentType)
+	field, err := d.field(parent, in.Info.FieldName)
+	if err != nil {
+		return driver.FieldResolveOutput{Error: &driver.Error{Message: err.Error()}}
+	}
+	key := pathKey(in.Info.Path)
+	if in.SubscriptionPayload != nil {
+		key = fmt.Sprintf("%s#%v", key, in.SubscriptionPayload)
+	}
+	return driver.FieldResolveOutput{Response: d.fieldValue(parent, field, key)}
+}
+
+// InterfaceResolveType implements driver.Driver
+func (d *Driver) InterfaceResolveType(in driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
+	t, err := d.resolveType(in.Function.Name, in.Value)
+	if err != nil {
+		return driver.InterfaceResolveTypeOutput{Error: &driver.Error{Message: err.Error()}}
+	}
+	return driver.InterfaceResolveTypeOutput{Type: t}
+}
+
+// UnionResolveType implements driver.Driver
+func (d *Driver) UnionResolveType(in driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
+	t, err := d.resolveType(in.Function.Name, in.Value)
+	if err != nil {
+		return driver.UnionResolveTypeOutput{Error: &driver.Error{Message: err.Error()}}
+	}
+	return driver.UnionResolveTypeOutput{Type: t}
+}
+
+// ScalarParse implements driver.Driver, values are returned as is
+func (d *Driver) ScalarParse(in driver.ScalarParseInput) driver.ScalarParseOutput {
+	return driver.ScalarParseOutput{Response: in.Value}
+}
+
+// ScalarSerialize implements driver.Driver, values are returned as is
+func (d *Driver) ScalarSerialize(in driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
+	return driver.ScalarSerializeOutput{Response: in.Value}
+}
+
+// Stream implements driver.Driver
+func (d *Driver) Stream(driver.StreamInput) driver.StreamOutput {
+	return driver.StreamOutput{Error: &driver.Error{Message: "mock driver does not support streams"}}
+}
+
+// SubscriptionConnection implements driver.Driver
+func (d *Driver) SubscriptionConnection(driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
+	return driver.SubscriptionConnectionOutput{}
+}
+
+// SubscriptionListen implements driver.Driver. Listener emits an event with its number
+// each period until closed.
+func (d *Driver) SubscriptionListen(driver.SubscriptionListenInput) driver.SubscriptionListenOutput {
+	period := d.SubscriptionPeriod
+	if period <= 0 {
+		period = defaultSubscriptionPeriod
+	}
+	return driver.SubscriptionListenOutput{
+		Reader: &tickReader{
+			ticker: time.NewTicker(period),
+			done:   make(chan struct{}),
+		},
+	}
+}
+
+func (d *Driver) field(parent, name string) (*graphql.FieldDefinition, error) {
+	if d.Schema == nil {
+		return nil, errors.New("mock driver does not have a schema")
+	}
+	obj, ok := d.Schema.Type(parent).(*graphql.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an object type", parent)
+	}
+	f, ok := obj.Fields()[name]
+	if !ok {
+		return nil, fmt.Errorf("%s does not have field %s", parent, name)
+	}
+	return f, nil
+}
+
+// resolveType returns type set in __typename of mocked object or first possible type of abstract type
+// by name
+func (d *Driver) resolveType(name string, v interface{}) (types.TypeRef, error) {
+	if d.Schema == nil {
+		return types.TypeRef{}, errors.New("mock driver does not have a schema")
+	}
+	abstract, ok := d.Schema.Type(name).(graphql.Abstract)
+	if !ok {
+		return types.TypeRef{}, fmt.Errorf("%s is not an abstract type", name)
+	}
+	possible := d.possibleTypes(abstract)
+	if len(possible) == 0 {
+		return types.TypeRef{}, fmt.Errorf("%s does not have possible types", name)
+	}
+	var typename interface{}
+	switch tv := v.(type) {
+	case Object:
+		typename = tv["__typename"]
+	case map[string]interface{}:
+		typename = tv["__typename"]
+	}
+	for _, p := range possible {
+		if p.Name() == typename {
+			return types.TypeRef{Name: p.Name()}, nil
+		}
+	}
+	return types.TypeRef{Name: possible[0].Name()}, nil
+}
+
+func (d *Driver) listSize() int {
+	if d.ListSize > 0 {
+		return d.ListSize
+	}
+	return defaultListSize
+}
+
+func (d *Driver) rand(key string) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return rand.New(rand.NewSource(d.Seed ^ int64(h.Sum64())))
+}
+
+func typeRefName(t *types.TypeRef) string {
+	for t != nil {
+		if t.Name != "" {
+			return t.Name
+		}
+		if t.NonNull != nil {
+			t = t.NonNull
+		} else {
+			t = t.List
+		}
+	}
+	return ""
+}
+
+func pathKey(p *types.ResponsePath) string {
+	var keys []interface{}
+	for ; p != nil; p = p.Prev {
+		keys = append(keys, p.Key)
+	}
+	var b bytes.Buffer
+	for i := len(keys) - 1; i >= 0; i-- {
+		fmt.Fprintf(&b, "/%v", keys[i])
+	}
+	return b.String()
+}
+
+type tickReader struct {
+	ticker *time.Ticker
+	done   chan struct{}
+	once   sync.Once
+	event  int
+}
+
+func (t *tickReader) Error() error {
+	return nil
+}
+
+func (t *tickReader) Next() bool {
+	select {
+	case <-t.done:
+		return false
+	case <-t.ticker.C:
+		t.event++
+		return true
+	}
+}
+
+func (t *tickReader) Read() (interface{}, error) {
+	return t.event, nil
+}
+
+func (t *tickReader) Close() error {
+	t.once.Do(func() {
+		t.ticker.Stop()
+		close(t.done)
+	})
+	return nil
+}
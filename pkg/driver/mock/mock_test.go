@@ -0,0 +1,117 @@
+package mock_test
+
+import (
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/mock"
+	"github.com/graphql-editor/stucco/pkg/parser"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `
+enum Role {
+	ADMIN
+	USER
+}
+type User {
+	id: ID!
+	email: String
+	role: Role!
+	tags: [String!]!
+	friends: [User!]
+}
+type Post {
+	title: String
+}
+union SearchResult = User | Post
+type Query {
+	user: User
+	search: [SearchResult!]!
+	count: Int
+}
+schema {
+	query: Query
+}
+`
+
+func newDriver(t *testing.T, seed int64, fixtures mock.Fixtures) *mock.Driver {
+	p := parser.NewParser(parser.Config{})
+	schema, err := p.Parse(testSchema)
+	require.NoError(t, err)
+	return &mock.Driver{Schema: &schema, Seed: seed, Fixtures: fixtures}
+}
+
+func resolve(d *mock.Driver, parent, field string) driver.FieldResolveOutput {
+	return d.FieldResolve(driver.FieldResolveInput{
+		Info: driver.FieldResolveInfo{
+			FieldName:  field,
+			ParentType: &types.TypeRef{Name: parent},
+			Path:       &types.ResponsePath{Key: field},
+		},
+	})
+}
+
+func TestFieldResolve(t *testing.T) {
+	d := newDriver(t, 1, nil)
+	out := resolve(d, "Query", "user")
+	require.Nil(t, out.Error)
+	user, ok := out.Response.(mock.Object)
+	require.True(t, ok)
+	assert.Equal(t, "User", user["__typename"])
+	assert.NotEmpty(t, user["id"])
+	assert.Contains(t, user["email"], "@example.com")
+	assert.Contains(t, []interface{}{"ADMIN", "USER"}, user["role"])
+	assert.NotEmpty(t, user["tags"])
+	assert.NotContains(t, user, "friends")
+
+	assert.Equal(t, out, resolve(newDriver(t, 1, nil), "Query", "user"))
+	assert.NotEqual(t, out, resolve(newDriver(t, 2, nil), "Query", "user"))
+
+	search := resolve(d, "Query", "search").Response.([]interface{})
+	require.NotEmpty(t, search)
+	for _, v := range search {
+		typename := v.(mock.Object)["__typename"]
+		assert.Contains(t, []interface{}{"User", "Post"}, typename)
+		rt := d.UnionResolveType(driver.UnionResolveTypeInput{
+			Function: types.Function{Name: "SearchResult"},
+			Value:    v,
+		})
+		require.Nil(t, rt.Error)
+		assert.Equal(t, typename, rt.Type.Name)
+	}
+
+	out = resolve(d, "Query", "missing")
+	assert.NotNil(t, out.Error)
+}
+
+func TestFieldResolveFixtures(t *testing.T) {
+	d := newDriver(t, 1, mock.Fixtures{
+		"Query.count": 42,
+		"Query.user":  map[string]interface{}{"email": "john@example.com"},
+		"User.role":   "ADMIN",
+	})
+	assert.Equal(t, 42, resolve(d, "Query", "count").Response)
+	user := resolve(d, "Query", "user").Response.(mock.Object)
+	assert.Equal(t, "john@example.com", user["email"])
+	assert.Equal(t, "ADMIN", user["role"])
+	assert.NotEmpty(t, user["id"])
+}
+
+func TestResolveTypeFallback(t *testing.T) {
+	d := newDriver(t, 1, nil)
+	rt := d.UnionResolveType(driver.UnionResolveTypeInput{
+		Function: types.Function{Name: "SearchResult"},
+		Value:    map[string]interface{}{},
+	})
+	require.Nil(t, rt.Error)
+	assert.Equal(t, "Post", rt.Type.Name)
+}
+
+func TestRegistry(t *testing.T) {
+	d, ok := driver.GetDriver(driver.Config{Provider: mock.Provider}).(*mock.Driver)
+	require.True(t, ok)
+	assert.Nil(t, d.Schema)
+}
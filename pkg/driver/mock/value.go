@@ -0,0 +1,268 @@
+package mock
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+)
+
+var (
+	firstNames = []string{"John", "Jane", "Alice", "Bob", "Carol", "Dave", "Eve", "Frank"}
+	lastNames  = []string{"Smith", "Johnson", "Brown", "Taylor", "Miller", "Wilson", "Moore", "Clark"}
+	cities     = []string{"London", "Paris", "Berlin", "Warsaw", "New York", "Tokyo", "Madrid", "Rome"}
+	words      = []string{
+		"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit",
+		"sed", "do", "eiusmod", "tempor", "incididunt", "ut", "labore", "et", "dolore", "magna",
+	}
+	baseTime = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// fieldValue returns value of a field of parent type, fixture takes precedence over generated value
+func (d *Driver) fieldValue(parent string, field *graphql.FieldDefinition, key string) interface{} {
+	r := d.rand(key)
+	fixture, ok := d.Fixtures[parent+"."+field.Name]
+	if !ok {
+		return d.value(field.Type, field.Name, r)
+	}
+	fixture = normalize(fixture)
+	overrides, ok := fixture.(map[string]interface{})
+	if !ok {
+		return fixture
+	}
+	t := field.Type
+	if nn, ok := t.(*graphql.NonNull); ok {
+		t = nn.OfType
+	}
+	obj, ok := t.(*graphql.Object)
+	if !ok {
+		return fixture
+	}
+	v := d.object(obj, r)
+	for k, ov := range overrides {
+		v[k] = ov
+	}
+	return v
+}
+
+func (d *Driver) value(t graphql.Type, name string, r *rand.Rand) interface{} {
+	switch tt := t.(type) {
+	case *graphql.NonNull:
+		return d.value(tt.OfType, name, r)
+	case *graphql.List:
+		l := make([]interface{}, 1+r.Intn(d.listSize()))
+		for i := range l {
+			l[i] = d.value(tt.OfType, name, r)
+		}
+		return l
+	case *graphql.Enum:
+		// order of values depends on schema parsing, sort them so that
+		// mocked data does not change between schema loads
+		values := make([]string, 0, len(tt.Values()))
+		for _, v := range tt.Values() {
+			values = append(values, v.Name)
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		sort.Strings(values)
+		return values[r.Intn(len(values))]
+	case *graphql.Scalar:
+		return scalarValue(tt.Name(), name, r)
+	case *graphql.Object:
+		return d.object(tt, r)
+	case graphql.Abstract:
+		if d.Schema == nil {
+			return nil
+		}
+		possible := d.possibleTypes(tt)
+		if len(possible) == 0 {
+			return nil
+		}
+		return d.object(possible[r.Intn(len(possible))], r)
+	}
+	return nil
+}
+
+// possibleTypes returns possible types of abstract type sorted by name, so that
+// mocked values do not depend on order in which schema was parsed
+func (d *Driver) possibleTypes(t graphql.Abstract) []*graphql.Object {
+	possible := append([]*graphql.Object{}, d.Schema.PossibleTypes(t)...)
+	sort.Slice(possible, func(i, j int) bool {
+		return possible[i].Name() < possible[j].Name()
+	})
+	return possible
+}
+
+// object creates mocked object with leaf fields, composite fields are resolved lazily
+func (d *Driver) object(obj *graphql.Object, r *rand.Rand) Object {
+	v := Object{"__typename": obj.Name()}
+	fields := obj.Fields()
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := fields[name]
+		if strings.HasPrefix(name, "__") {
+			continue
+		}
+		switch unwrap(f.Type).(type) {
+		case *graphql.Scalar, *graphql.Enum:
+		default:
+			continue
+		}
+		if fixture, ok := d.Fixtures[obj.Name()+"."+name]; ok {
+			v[name] = normalize(fixture)
+			continue
+		}
+		v[name] = d.value(f.Type, name, rand.New(rand.NewSource(r.Int63())))
+	}
+	return v
+}
+
+func unwrap(t graphql.Type) graphql.Type {
+	for {
+		switch tt := t.(type) {
+		case *graphql.NonNull:
+			t = tt.OfType
+		case *graphql.List:
+			t = tt.OfType
+		default:
+			return t
+		}
+	}
+}
+
+func scalarValue(scalar, field string, r *rand.Rand) interface{} {
+	lower := strings.ToLower(field)
+	switch scalar {
+	case "Boolean":
+		return r.Intn(2) == 1
+	case "Int":
+		switch {
+		case strings.Contains(lower, "age"):
+			return 18 + r.Intn(60)
+		case strings.Contains(lower, "year"):
+			return 1990 + r.Intn(35)
+		}
+		return r.Intn(100)
+	case "Float":
+		switch {
+		case strings.HasPrefix(lower, "lat"):
+			return float64(r.Intn(180000)-90000) / 1000
+		case strings.HasPrefix(lower, "lng"), strings.HasPrefix(lower, "lon"):
+			return float64(r.Intn(360000)-180000) / 1000
+		}
+		return float64(r.Intn(100000)) / 100
+	case "ID":
+		return uuid(r)
+	case "String":
+		return stringValue(field, r)
+	}
+	switch strings.ToLower(scalar) {
+	case "datetime", "time", "timestamp":
+		return dateTime(r).Format(time.RFC3339)
+	case "date":
+		return dateTime(r).Format("2006-01-02")
+	case "url", "uri":
+		return fmt.Sprintf("https://example.com/%s", word(r))
+	case "email":
+		return email(r)
+	case "uuid":
+		return uuid(r)
+	case "json":
+		return map[string]interface{}{word(r): word(r)}
+	}
+	return stringValue(field, r)
+}
+
+func stringValue(name string, r *rand.Rand) string {
+	field := strings.ToLower(name)
+	switch {
+	case field == "id" || strings.HasSuffix(name, "Id") || strings.Contains(field, "uuid"):
+		return uuid(r)
+	case strings.Contains(field, "email"):
+		return email(r)
+	case strings.Contains(field, "firstname"):
+		return pick(firstNames, r)
+	case strings.Contains(field, "lastname"), strings.Contains(field, "surname"):
+		return pick(lastNames, r)
+	case strings.Contains(field, "name"):
+		return pick(firstNames, r) + " " + pick(lastNames, r)
+	case strings.Contains(field, "url"), strings.Contains(field, "avatar"), strings.Contains(field, "image"):
+		return fmt.Sprintf("https://example.com/%s", word(r))
+	case strings.Contains(field, "phone"):
+		return fmt.Sprintf("+1-555-%03d-%04d", r.Intn(1000), r.Intn(10000))
+	case strings.HasSuffix(name, "At"), strings.Contains(field, "date"):
+		return dateTime(r).Format(time.RFC3339)
+	case strings.Contains(field, "city"):
+		return pick(cities, r)
+	case strings.Contains(field, "title"):
+		return strings.Title(sentence(r, 3))
+	case strings.Contains(field, "description"), strings.Contains(field, "bio"), strings.Contains(field, "content"):
+		return sentence(r, 12)
+	}
+	return sentence(r, 2)
+}
+
+func pick(l []string, r *rand.Rand) string {
+	return l[r.Intn(len(l))]
+}
+
+func word(r *rand.Rand) string {
+	return pick(words, r)
+}
+
+func sentence(r *rand.Rand, n int) string {
+	w := make([]string, n)
+	for i := range w {
+		w[i] = word(r)
+	}
+	return strings.Join(w, " ")
+}
+
+func email(r *rand.Rand) string {
+	return strings.ToLower(pick(firstNames, r)+"."+pick(lastNames, r)) + "@example.com"
+}
+
+func uuid(r *rand.Rand) string {
+	b := make([]byte, 16)
+	r.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func dateTime(r *rand.Rand) time.Time {
+	return baseTime.Add(time.Duration(r.Int63n(int64(5*365*24*time.Hour))) / time.Second * time.Second)
+}
+
+// normalize converts maps decoded from yaml to map[string]interface{}
+func normalize(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(vv))
+		for k, e := range vv {
+			m[fmt.Sprint(k)] = normalize(e)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(vv))
+		for k, e := range vv {
+			m[k] = normalize(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(vv))
+		for i, e := range vv {
+			l[i] = normalize(e)
+		}
+		return l
+	}
+	return v
+}
@@ -34,11 +34,29 @@ type driverShim interface {
 	SubscriptionListen(driver.SubscriptionListenInput) driver.SubscriptionListenOutput
 }
 
+type contextDriverShim interface {
+	driverShim
+	AuthorizeContext(context.Context, driver.AuthorizeInput) driver.AuthorizeOutput
+	FieldResolveContext(context.Context, driver.FieldResolveInput) driver.FieldResolveOutput
+	InterfaceResolveTypeContext(context.Context, driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput
+	ScalarParseContext(context.Context, driver.ScalarParseInput) driver.ScalarParseOutput
+	ScalarSerializeContext(context.Context, driver.ScalarSerializeInput) driver.ScalarSerializeOutput
+	UnionResolveTypeContext(context.Context, driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput
+	StreamContext(context.Context, driver.StreamInput) driver.StreamOutput
+	SubscriptionConnectionContext(context.Context, driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput
+	SubscriptionListenContext(context.Context, driver.SubscriptionListenInput) driver.SubscriptionListenOutput
+}
+
 type driverClient struct {
 	driverShim
 	plugin *Plugin
 }
 
+type contextDriverClient struct {
+	contextDriverShim
+	plugin *Plugin
+}
+
 // Client interface used to establish connection with plugin
 type Client interface {
 	Client() (plugin.ClientProtocol, error)
@@ -57,12 +75,17 @@ func (d driverClient) SetSecrets(in driver.SetSecretsInput) driver.SetSecretsOut
 	return d.plugin.SetSecrets(in)
 }
 
+func (d contextDriverClient) SetSecrets(in driver.SetSecretsInput) driver.SetSecretsOutput {
+	return d.plugin.SetSecrets(in)
+}
+
 type pluginResponse struct {
 	data interface{}
 	err  error
 }
 
 type pluginPayload struct {
+	ctx  context.Context
 	data interface{}
 	out  chan *pluginResponse
 }
@@ -75,42 +98,39 @@ func (r pluginRunner) do(p *Plugin, payload *pluginPayload) {
 	}()
 	dri, err := p.getDriver()
 	if err != nil {
-		go func() {
-			payload.out <- &pluginResponse{
-				err: err,
-			}
-		}()
+		payload.out <- &pluginResponse{
+			err: err,
+		}
 		return
 	}
 	var resp interface{}
+	ctx := payload.ctx
 	switch data := payload.data.(type) {
 	case driver.AuthorizeInput:
-		resp = dri.Authorize(data)
+		resp = dri.AuthorizeContext(ctx, data)
 	case driver.FieldResolveInput:
-		resp = dri.FieldResolve(data)
+		resp = dri.FieldResolveContext(ctx, data)
 	case driver.InterfaceResolveTypeInput:
-		resp = dri.InterfaceResolveType(data)
+		resp = dri.InterfaceResolveTypeContext(ctx, data)
 	case driver.ScalarParseInput:
-		resp = dri.ScalarParse(data)
+		resp = dri.ScalarParseContext(ctx, data)
 	case driver.ScalarSerializeInput:
-		resp = dri.ScalarSerialize(data)
+		resp = dri.ScalarSerializeContext(ctx, data)
 	case driver.UnionResolveTypeInput:
-		resp = dri.UnionResolveType(data)
+		resp = dri.UnionResolveTypeContext(ctx, data)
 	case driver.StreamInput:
-		resp = dri.Stream(data)
+		resp = dri.StreamContext(ctx, data)
 	case driver.SubscriptionConnectionInput:
-		resp = dri.SubscriptionConnection(data)
+		resp = dri.SubscriptionConnectionContext(ctx, data)
 	case driver.SubscriptionListenInput:
-		resp = dri.SubscriptionListen(data)
+		resp = dri.SubscriptionListenContext(ctx, data)
 	default:
 		err = errors.New("unknown input")
 	}
-	go func() {
-		payload.out <- &pluginResponse{
-			data: resp,
-			err:  err,
-		}
-	}()
+	payload.out <- &pluginResponse{
+		data: resp,
+		err:  err,
+	}
 }
 
 // Plugin implements Driver interface by running an executable available on local
@@ -177,12 +197,15 @@ func (p *Plugin) getClientShim() (driverShim, error) {
 	return driver, nil
 }
 
-func (p *Plugin) getDriver() (driver.Driver, error) {
-	driver, err := p.getClientShim()
+func (p *Plugin) getDriver() (driver.ContextDriver, error) {
+	shim, err := p.getClientShim()
 	if err != nil {
 		return nil, err
 	}
-	return driverClient{driver, p}, nil
+	if cshim, ok := shim.(contextDriverShim); ok {
+		return contextDriverClient{cshim, p}, nil
+	}
+	return driver.WithContext(driverClient{shim, p}), nil
 }
 
 // ExecCommand creates new plugin command
@@ -291,18 +314,31 @@ func (p *Plugin) handshake() plugin.HandshakeConfig {
 	}
 }
 
-func (p *Plugin) do(data interface{}) (interface{}, error) {
+// do waits for a free runner and executes request on it. If context is done before
+// request is finished, do returns context error.
+func (p *Plugin) do(ctx context.Context, data interface{}) (interface{}, error) {
 	if err := p.start(); err != nil {
 		return nil, err
 	}
 	payload := pluginPayload{
+		ctx:  ctx,
 		data: data,
-		out:  make(chan *pluginResponse),
+		// buffered, so that runner does not block on abandoned request
+		out: make(chan *pluginResponse, 1),
+	}
+	var r pluginRunner
+	select {
+	case r = <-p.getRunner:
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
-	r := <-p.getRunner
 	r <- &payload
-	resp := <-payload.out
-	return resp.data, resp.err
+	select {
+	case resp := <-payload.out:
+		return resp.data, resp.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 }
 
 // SetSecrets sets user provided secrets for plugin using environment variables
@@ -322,9 +358,14 @@ func (p *Plugin) SetSecrets(in driver.SetSecretsInput) driver.SetSecretsOutput {
 	return driver.SetSecretsOutput{}
 }
 
-// FieldResolve uses plugin to resolve a field on type
+// FieldResolve calls FieldResolveContext with background context
 func (p *Plugin) FieldResolve(in driver.FieldResolveInput) driver.FieldResolveOutput {
-	resp, err := p.do(in)
+	return p.FieldResolveContext(context.Background(), in)
+}
+
+// FieldResolveContext uses plugin to resolve a field on type
+func (p *Plugin) FieldResolveContext(ctx context.Context, in driver.FieldResolveInput) driver.FieldResolveOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.FieldResolveOutput{
 			Error: &driver.Error{
@@ -335,9 +376,36 @@ func (p *Plugin) FieldResolve(in driver.FieldResolveInput) driver.FieldResolveOu
 	return resp.(driver.FieldResolveOutput)
 }
 
-// InterfaceResolveType uses plugin to find interface type for user input
+// FieldResolveBatch calls FieldResolveBatchContext with background context
+func (p *Plugin) FieldResolveBatch(in driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	return p.FieldResolveBatchContext(context.Background(), in)
+}
+
+// FieldResolveBatchContext resolves all fields in batch using available plugin runners
+func (p *Plugin) FieldResolveBatchContext(ctx context.Context, in driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	out := driver.FieldResolveBatchOutput{
+		Outputs: make([]driver.FieldResolveOutput, len(in.Inputs)),
+	}
+	var wg sync.WaitGroup
+	wg.Add(len(in.Inputs))
+	for i := range in.Inputs {
+		go func(i int) {
+			defer wg.Done()
+			out.Outputs[i] = p.FieldResolveContext(ctx, in.Inputs[i])
+		}(i)
+	}
+	wg.Wait()
+	return out
+}
+
+// InterfaceResolveType calls InterfaceResolveTypeContext with background context
 func (p *Plugin) InterfaceResolveType(in driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
-	resp, err := p.do(in)
+	return p.InterfaceResolveTypeContext(context.Background(), in)
+}
+
+// InterfaceResolveTypeContext uses plugin to find interface type for user input
+func (p *Plugin) InterfaceResolveTypeContext(ctx context.Context, in driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.InterfaceResolveTypeOutput{
 			Error: &driver.Error{
@@ -348,9 +416,14 @@ func (p *Plugin) InterfaceResolveType(in driver.InterfaceResolveTypeInput) drive
 	return resp.(driver.InterfaceResolveTypeOutput)
 }
 
-// ScalarParse uses plugin to parse scalar
+// ScalarParse calls ScalarParseContext with background context
 func (p *Plugin) ScalarParse(in driver.ScalarParseInput) driver.ScalarParseOutput {
-	resp, err := p.do(in)
+	return p.ScalarParseContext(context.Background(), in)
+}
+
+// ScalarParseContext uses plugin to parse scalar
+func (p *Plugin) ScalarParseContext(ctx context.Context, in driver.ScalarParseInput) driver.ScalarParseOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.ScalarParseOutput{
 			Error: &driver.Error{
@@ -361,9 +434,14 @@ func (p *Plugin) ScalarParse(in driver.ScalarParseInput) driver.ScalarParseOutpu
 	return resp.(driver.ScalarParseOutput)
 }
 
-// ScalarSerialize uses plugin to serialize scalar
+// ScalarSerialize calls ScalarSerializeContext with background context
 func (p *Plugin) ScalarSerialize(in driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
-	resp, err := p.do(in)
+	return p.ScalarSerializeContext(context.Background(), in)
+}
+
+// ScalarSerializeContext uses plugin to serialize scalar
+func (p *Plugin) ScalarSerializeContext(ctx context.Context, in driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.ScalarSerializeOutput{
 			Error: &driver.Error{
@@ -374,9 +452,14 @@ func (p *Plugin) ScalarSerialize(in driver.ScalarSerializeInput) driver.ScalarSe
 	return resp.(driver.ScalarSerializeOutput)
 }
 
-// UnionResolveType uses plugin to find union type for user input
+// UnionResolveType calls UnionResolveTypeContext with background context
 func (p *Plugin) UnionResolveType(in driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
-	resp, err := p.do(in)
+	return p.UnionResolveTypeContext(context.Background(), in)
+}
+
+// UnionResolveTypeContext uses plugin to find union type for user input
+func (p *Plugin) UnionResolveTypeContext(ctx context.Context, in driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.UnionResolveTypeOutput{
 			Error: &driver.Error{
@@ -387,9 +470,14 @@ func (p *Plugin) UnionResolveType(in driver.UnionResolveTypeInput) driver.UnionR
 	return resp.(driver.UnionResolveTypeOutput)
 }
 
-// Stream data through grpc plugin
+// Stream calls StreamContext with background context
 func (p *Plugin) Stream(in driver.StreamInput) driver.StreamOutput {
-	resp, err := p.do(in)
+	return p.StreamContext(context.Background(), in)
+}
+
+// StreamContext streams data through grpc plugin
+func (p *Plugin) StreamContext(ctx context.Context, in driver.StreamInput) driver.StreamOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.StreamOutput{
 			Error: &driver.Error{
@@ -400,9 +488,14 @@ func (p *Plugin) Stream(in driver.StreamInput) driver.StreamOutput {
 	return resp.(driver.StreamOutput)
 }
 
-// SubscriptionConnection creates connection with plugin
+// SubscriptionConnection calls SubscriptionConnectionContext with background context
 func (p *Plugin) SubscriptionConnection(in driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
-	resp, err := p.do(in)
+	return p.SubscriptionConnectionContext(context.Background(), in)
+}
+
+// SubscriptionConnectionContext creates connection with plugin
+func (p *Plugin) SubscriptionConnectionContext(ctx context.Context, in driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.SubscriptionConnectionOutput{
 			Error: &driver.Error{
@@ -413,9 +506,14 @@ func (p *Plugin) SubscriptionConnection(in driver.SubscriptionConnectionInput) d
 	return resp.(driver.SubscriptionConnectionOutput)
 }
 
-// SubscriptionListen creates listen stream with plugin
+// SubscriptionListen calls SubscriptionListenContext with background context
 func (p *Plugin) SubscriptionListen(in driver.SubscriptionListenInput) driver.SubscriptionListenOutput {
-	resp, err := p.do(in)
+	return p.SubscriptionListenContext(context.Background(), in)
+}
+
+// SubscriptionListenContext creates listen stream with plugin
+func (p *Plugin) SubscriptionListenContext(ctx context.Context, in driver.SubscriptionListenInput) driver.SubscriptionListenOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.SubscriptionListenOutput{
 			Error: &driver.Error{
@@ -426,9 +524,14 @@ func (p *Plugin) SubscriptionListen(in driver.SubscriptionListenInput) driver.Su
 	return resp.(driver.SubscriptionListenOutput)
 }
 
-// Authorize uses plugin to authorize request
+// Authorize calls AuthorizeContext with background context
 func (p *Plugin) Authorize(in driver.AuthorizeInput) driver.AuthorizeOutput {
-	resp, err := p.do(in)
+	return p.AuthorizeContext(context.Background(), in)
+}
+
+// AuthorizeContext uses plugin to authorize request
+func (p *Plugin) AuthorizeContext(ctx context.Context, in driver.AuthorizeInput) driver.AuthorizeOutput {
+	resp, err := p.do(ctx, in)
 	if err != nil {
 		return driver.AuthorizeOutput{
 			Error: &driver.Error{
@@ -2,6 +2,7 @@ package protohttp
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
@@ -9,14 +10,19 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// Authorize over http
+// Authorize calls AuthorizeContext with background context.
 func (c *Client) Authorize(input driver.AuthorizeInput) driver.AuthorizeOutput {
+	return c.AuthorizeContext(context.Background(), input)
+}
+
+// AuthorizeContext over http
+func (c *Client) AuthorizeContext(ctx context.Context, input driver.AuthorizeInput) driver.AuthorizeOutput {
 	var out driver.AuthorizeOutput
 	var body bytes.Buffer
 	err := protodriver.WriteAuthorizeInput(&body, input)
 	if err == nil {
 		var b []byte
-		if b, err = c.do(message{
+		if b, err = c.do(ctx, message{
 			contentType:         authorizeRequestMessage,
 			responseContentType: authorizeResponseMessage,
 			b:                   body.Bytes(),
@@ -25,9 +31,7 @@ func (c *Client) Authorize(input driver.AuthorizeInput) driver.AuthorizeOutput {
 		}
 	}
 	if err != nil {
-		out.Error = &driver.Error{
-			Message: err.Error(),
-		}
+		out.Error = driverError(err)
 	}
 	return out
 }
@@ -39,17 +43,20 @@ func (h *Handler) authorize(req *http.Request, rw http.ResponseWriter) error {
 		req.Body.Close()
 		if err == nil {
 			var driverResp bool
-			driverResp, err = h.Authorize(in)
+			var claims interface{}
+			if m, ok := h.Muxer.(ClaimsMuxer); ok {
+				driverResp, claims, err = m.AuthorizeClaims(in)
+			} else {
+				driverResp, err = h.Authorize(in)
+			}
 			if err == nil {
-				err = protodriver.WriteAuthorizeOutput(rw, driverResp)
+				err = protodriver.WriteAuthorizeClaimsOutput(rw, driverResp, claims)
 			}
 		}
 	}
 	if err != nil {
 		err = writeProto(rw, &protoMessages.AuthorizeResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		})
 	}
 	return err
@@ -0,0 +1,87 @@
+package protohttp_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/protohttp"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthorizeField(t *testing.T) {
+	mockMuxer := new(mockMuxer)
+	mockMuxer.On("Authorize", mock.MatchedBy(func(in driver.AuthorizeInput) bool {
+		return in.Info != nil &&
+			in.Info.FieldName == "field" &&
+			in.Source == "source" &&
+			in.Arguments["arg"] == "value"
+	})).Return(true, nil)
+	mockMuxer.On("Authorize", mock.Anything).Return(false, nil)
+	srv := httptest.NewServer(&protohttp.Handler{
+		Muxer: mockMuxer,
+	})
+	defer srv.Close()
+	client := protohttp.NewClient(protohttp.Config{
+		Client: srv.Client(),
+		URL:    srv.URL,
+	})
+	out := client.Authorize(driver.AuthorizeInput{
+		Function:  types.Function{Name: "function"},
+		Source:    "source",
+		Arguments: types.Arguments{"arg": "value"},
+		Info: &driver.FieldResolveInfo{
+			FieldName:  "field",
+			ParentType: &types.TypeRef{Name: "Query"},
+			ReturnType: &types.TypeRef{Name: "String"},
+		},
+	})
+	assert.Equal(t, driver.AuthorizeOutput{Response: true}, out)
+	out = client.Authorize(driver.AuthorizeInput{
+		Function: types.Function{Name: "function"},
+	})
+	assert.Equal(t, driver.AuthorizeOutput{Response: false}, out)
+}
+
+type claimsMuxer struct {
+	*mockMuxer
+}
+
+func (c claimsMuxer) AuthorizeClaims(in driver.AuthorizeInput) (bool, interface{}, error) {
+	return true, map[string]interface{}{"sub": "user"}, nil
+}
+
+func TestAuthorizeClaims(t *testing.T) {
+	mockMuxer := new(mockMuxer)
+	mockMuxer.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		claims, _ := in.Claims.(map[string]interface{})
+		return claims["sub"] == "user"
+	})).Return("user", nil)
+	srv := httptest.NewServer(&protohttp.Handler{
+		Muxer: claimsMuxer{mockMuxer},
+	})
+	defer srv.Close()
+	client := protohttp.NewClient(protohttp.Config{
+		Client: srv.Client(),
+		URL:    srv.URL,
+	})
+	out := client.Authorize(driver.AuthorizeInput{
+		Function: types.Function{Name: "function"},
+	})
+	assert.Equal(t, driver.AuthorizeOutput{
+		Response: true,
+		Claims:   map[string]interface{}{"sub": "user"},
+	}, out)
+	fieldOut := client.FieldResolve(driver.FieldResolveInput{
+		Function: types.Function{Name: "function"},
+		Claims:   out.Claims,
+		Info: driver.FieldResolveInfo{
+			FieldName:  "field",
+			ParentType: &types.TypeRef{Name: "Query"},
+			ReturnType: &types.TypeRef{Name: "String"},
+		},
+	})
+	assert.Equal(t, driver.FieldResolveOutput{Response: "user"}, fieldOut)
+}
@@ -2,11 +2,14 @@ package protohttp
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime"
 	"net/http"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
 )
 
 // HTTPClient for protocol buffer
@@ -14,17 +17,31 @@ type HTTPClient interface {
 	Post(url, contentType string, body io.Reader) (*http.Response, error)
 }
 
+// HTTPContextClient can be optionally implemented by HTTPClient to
+// support request cancellation.
+type HTTPContextClient interface {
+	PostContext(ctx context.Context, url, contentType string, body io.Reader) (*http.Response, error)
+}
+
+type httpDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 // Client implements driver by using Protocol Buffers over HTTP
 type Client struct {
 	HTTPClient
 	// URL of a proto server endpoint
 	URL string
+	// Batch enables sending field resolution batches in one
+	// FieldResolveBatchRequest. Server must support it.
+	Batch bool
 }
 
 // Config for new .Client
 type Config struct {
 	Client *http.Client
 	URL    string
+	Batch  bool
 }
 
 // NewClient creates a a new client
@@ -35,6 +52,7 @@ func NewClient(config Config) Client {
 	return Client{
 		HTTPClient: config.Client,
 		URL:        config.URL,
+		Batch:      config.Batch,
 	}
 }
 
@@ -44,8 +62,50 @@ type message struct {
 	b                   []byte
 }
 
-func (c *Client) do(in message) ([]byte, error) {
-	resp, err := c.Post(c.URL, in.contentType.String(), bytes.NewReader(in.b))
+func (c *Client) post(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
+	switch cli := c.HTTPClient.(type) {
+	case HTTPContextClient:
+		return cli.PostContext(ctx, c.URL, contentType, body)
+	case httpDoer:
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, body)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(contentTypeHeader, contentType)
+		return cli.Do(req)
+	}
+	return c.Post(c.URL, contentType, body)
+}
+
+// unavailableError is returned when request did not reach function
+// or function host could not handle it
+type unavailableError struct {
+	error
+}
+
+func driverError(err error) *driver.Error {
+	derr := &driver.Error{
+		Message: err.Error(),
+	}
+	if _, ok := err.(unavailableError); ok {
+		derr.Code = driver.ErrorCodeUnavailable
+	}
+	return derr
+}
+
+func isUnavailableStatus(status int) bool {
+	return status >= http.StatusInternalServerError || status == http.StatusTooManyRequests
+}
+
+func (c *Client) do(ctx context.Context, in message) ([]byte, error) {
+	resp, err := c.post(ctx, in.contentType.String(), bytes.NewReader(in.b))
+	if err != nil {
+		// canceled or timed out call says nothing about function health
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		err = unavailableError{err}
+	}
 	if err == nil {
 		defer resp.Body.Close()
 		if resp.StatusCode != http.StatusOK {
@@ -54,6 +114,9 @@ func (c *Client) do(in message) ([]byte, error) {
 			if err == nil {
 				err = fmt.Errorf(`status_code=%d message="%s"`, resp.StatusCode, string(b))
 			}
+			if isUnavailableStatus(resp.StatusCode) {
+				err = unavailableError{err}
+			}
 		}
 	}
 	if err == nil {
@@ -1,6 +1,9 @@
 package protohttp_test
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
@@ -10,4 +13,37 @@ import (
 
 func TestClientImplementsDriver(t *testing.T) {
 	assert.Implements(t, (*driver.Driver)(nil), new(protohttp.Client))
+	assert.Implements(t, (*driver.ContextDriver)(nil), new(protohttp.Client))
+}
+
+func TestClientCanceledContext(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+	client := protohttp.NewClient(protohttp.Config{
+		Client: srv.Client(),
+		URL:    srv.URL,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	out := client.FieldResolveContext(ctx, driver.FieldResolveInput{})
+	assert.Equal(t, &driver.Error{Message: context.Canceled.Error()}, out.Error)
+	assert.False(t, called)
+}
+
+func TestClientUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	client := protohttp.NewClient(protohttp.Config{
+		Client: srv.Client(),
+		URL:    srv.URL,
+	})
+	out := client.FieldResolve(driver.FieldResolveInput{})
+	if assert.NotNil(t, out.Error) {
+		assert.Equal(t, driver.ErrorCodeUnavailable, out.Error.Code)
+	}
 }
@@ -2,6 +2,7 @@ package protohttp
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
@@ -9,14 +10,19 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// FieldResolve over http
+// FieldResolve calls FieldResolveContext with background context.
 func (c *Client) FieldResolve(input driver.FieldResolveInput) driver.FieldResolveOutput {
+	return c.FieldResolveContext(context.Background(), input)
+}
+
+// FieldResolveContext over http
+func (c *Client) FieldResolveContext(ctx context.Context, input driver.FieldResolveInput) driver.FieldResolveOutput {
 	var out driver.FieldResolveOutput
 	var body bytes.Buffer
 	err := protodriver.WriteFieldResolveInput(&body, input)
 	if err == nil {
 		var b []byte
-		if b, err = c.do(message{
+		if b, err = c.do(ctx, message{
 			contentType:         fieldResolveRequestMessage,
 			responseContentType: fieldResolveResponseMessage,
 			b:                   body.Bytes(),
@@ -25,9 +31,7 @@ func (c *Client) FieldResolve(input driver.FieldResolveInput) driver.FieldResolv
 		}
 	}
 	if err != nil {
-		out.Error = &driver.Error{
-			Message: err.Error(),
-		}
+		out.Error = driverError(err)
 	}
 	return out
 }
@@ -47,9 +51,7 @@ func (h *Handler) fieldResolve(req *http.Request, rw http.ResponseWriter) error
 	}
 	if err != nil {
 		err = writeProto(rw, &protoMessages.FieldResolveResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		})
 	}
 	return err
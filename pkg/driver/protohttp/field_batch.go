@@ -0,0 +1,65 @@
+package protohttp
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	protodriver "github.com/graphql-editor/stucco/pkg/proto/driver"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+)
+
+// FieldResolveBatch calls FieldResolveBatchContext with background context.
+func (c *Client) FieldResolveBatch(input driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	return c.FieldResolveBatchContext(context.Background(), input)
+}
+
+// FieldResolveBatchContext sends all field resolutions in one http request if
+// client has batching enabled. Otherwise, as runtimes are not required to handle
+// FieldResolveBatchRequest, each field is resolved with a separate request.
+func (c *Client) FieldResolveBatchContext(ctx context.Context, input driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	if !c.Batch {
+		return driver.FieldResolveEach(ctx, c, input)
+	}
+	var out driver.FieldResolveBatchOutput
+	var body bytes.Buffer
+	err := protodriver.WriteFieldResolveBatchInput(&body, input)
+	if err == nil {
+		var b []byte
+		if b, err = c.do(ctx, message{
+			contentType:         fieldResolveBatchRequestMessage,
+			responseContentType: fieldResolveBatchResponseMessage,
+			b:                   body.Bytes(),
+		}); err == nil {
+			out, err = protodriver.ReadFieldResolveBatchOutput(bytes.NewReader(b))
+		}
+	}
+	if err != nil {
+		out = driver.FieldResolveBatchOutput{
+			Error: driverError(err),
+		}
+	}
+	return out
+}
+
+func (h *Handler) fieldResolveBatch(req *http.Request, rw http.ResponseWriter) error {
+	rw.Header().Add(contentTypeHeader, fieldResolveBatchResponseMessage.String())
+	in, err := protodriver.ReadFieldResolveBatchInput(req.Body)
+	if err == nil {
+		req.Body.Close()
+		results := make([]protodriver.FieldResolveBatchResult, 0, len(in.Inputs))
+		for _, input := range in.Inputs {
+			var r protodriver.FieldResolveBatchResult
+			r.Response, r.Error = h.FieldResolve(input)
+			results = append(results, r)
+		}
+		err = protodriver.WriteFieldResolveBatchOutput(rw, protodriver.MakeFieldResolveBatchResponse(results))
+	}
+	if err != nil {
+		err = protodriver.WriteFieldResolveBatchOutput(rw, &protoMessages.FieldResolveBatchResponse{
+			Error: protodriver.MakeProtoError(err),
+		})
+	}
+	return err
+}
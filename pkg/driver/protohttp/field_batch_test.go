@@ -0,0 +1,72 @@
+package protohttp_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/protohttp"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFieldResolveBatch(t *testing.T) {
+	data := []struct {
+		title    string
+		batch    bool
+		requests int32
+	}{
+		{
+			title:    "RequestPerField",
+			requests: 2,
+		},
+		{
+			title:    "SingleRequest",
+			batch:    true,
+			requests: 1,
+		},
+	}
+	for _, tt := range data {
+		t.Run(tt.title, func(t *testing.T) {
+			mockMuxer := new(mockMuxer)
+			mockMuxer.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+				return in.Source == "a"
+			})).Return("response a", nil)
+			mockMuxer.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+				return in.Source == "b"
+			})).Return(nil, errors.New("error b"))
+			handler := &protohttp.Handler{
+				Muxer: mockMuxer,
+			}
+			var requests int32
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				handler.ServeHTTP(rw, req)
+			}))
+			defer srv.Close()
+			client := protohttp.NewClient(protohttp.Config{
+				Client: srv.Client(),
+				URL:    srv.URL,
+				Batch:  tt.batch,
+			})
+			out := client.FieldResolveBatch(driver.FieldResolveBatchInput{
+				Inputs: []driver.FieldResolveInput{
+					{Function: types.Function{Name: "function"}, Source: "a"},
+					{Function: types.Function{Name: "function"}, Source: "b"},
+				},
+			})
+			assert.Equal(t, driver.FieldResolveBatchOutput{
+				Outputs: []driver.FieldResolveOutput{
+					{Response: "response a"},
+					{Error: &driver.Error{Message: "error b"}},
+				},
+			}, out)
+			mockMuxer.AssertNumberOfCalls(t, "FieldResolve", 2)
+			assert.Equal(t, tt.requests, atomic.LoadInt32(&requests))
+		})
+	}
+}
@@ -8,10 +8,14 @@ import (
 	"testing"
 
 	protobuf "google.golang.org/protobuf/proto"
+	"github.com/graphql-editor/stucco/pkg/driver"
 	"github.com/graphql-editor/stucco/pkg/driver/protohttp"
+	protodriver "github.com/graphql-editor/stucco/pkg/proto/driver"
 	"github.com/graphql-editor/stucco/pkg/proto/prototest"
+	"github.com/graphql-editor/stucco/pkg/types"
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -59,3 +63,44 @@ func TestServerFieldResolve(t *testing.T) {
 		assert.NoError(t, protobuf.Unmarshal(responseRecorder.Body.Bytes(), &protoResp))
 	})
 }
+
+func TestFieldResolveStructuredError(t *testing.T) {
+	driverErr := &driver.Error{
+		Message:    "user not found",
+		Code:       "NOT_FOUND",
+		Extensions: map[string]interface{}{"id": "1"},
+		Path:       []interface{}{"friends", float64(0)},
+	}
+	// error details are sent in fields of Error known to all runtimes
+	protoErr := protodriver.MakeProtoError(driverErr)
+	assert.Equal(t, "NOT_FOUND", protoErr.GetCode())
+	assert.Equal(t, "1", protoErr.GetExtensions()["id"].GetS())
+	assert.Len(t, protoErr.GetPath(), 2)
+	assert.Empty(t, protoErr.ProtoReflect().GetUnknown())
+	mockMuxer := new(mockMuxer)
+	mockMuxer.On("FieldResolve", mock.Anything).Return(nil, driverErr)
+	srv := httptest.NewServer(&protohttp.Handler{
+		Muxer: mockMuxer,
+	})
+	defer srv.Close()
+	client := protohttp.NewClient(protohttp.Config{
+		Client: srv.Client(),
+		URL:    srv.URL,
+	})
+	out := client.FieldResolve(driver.FieldResolveInput{
+		Function: types.Function{Name: "function"},
+		Info: driver.FieldResolveInfo{
+			FieldName:  "user",
+			ParentType: &types.TypeRef{Name: "Query"},
+			ReturnType: &types.TypeRef{Name: "User"},
+		},
+	})
+	assert.Equal(t, driver.FieldResolveOutput{
+		Error: &driver.Error{
+			Message:    "user not found",
+			Code:       "NOT_FOUND",
+			Extensions: map[string]interface{}{"id": "1"},
+			Path:       []interface{}{"friends", float64(0)},
+		},
+	}, out)
+}
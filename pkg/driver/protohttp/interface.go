@@ -2,6 +2,7 @@ package protohttp
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
@@ -9,14 +10,19 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// InterfaceResolveType over http
+// InterfaceResolveType calls InterfaceResolveTypeContext with background context.
 func (c *Client) InterfaceResolveType(input driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
+	return c.InterfaceResolveTypeContext(context.Background(), input)
+}
+
+// InterfaceResolveTypeContext over http
+func (c *Client) InterfaceResolveTypeContext(ctx context.Context, input driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
 	var out driver.InterfaceResolveTypeOutput
 	var body bytes.Buffer
 	err := protodriver.WriteInterfaceResolveTypeInput(&body, input)
 	if err == nil {
 		var b []byte
-		if b, err = c.do(message{
+		if b, err = c.do(ctx, message{
 			contentType:         interfaceResolveTypeRequestMessage,
 			responseContentType: interfaceResolveTypeResponseMessage,
 			b:                   body.Bytes(),
@@ -25,9 +31,7 @@ func (c *Client) InterfaceResolveType(input driver.InterfaceResolveTypeInput) dr
 		}
 	}
 	if err != nil {
-		out.Error = &driver.Error{
-			Message: err.Error(),
-		}
+		out.Error = driverError(err)
 	}
 	return out
 }
@@ -47,9 +51,7 @@ func (h *Handler) interfaceResolveType(req *http.Request, rw http.ResponseWriter
 	}
 	if err != nil {
 		err = writeProto(rw, &protoMessages.InterfaceResolveTypeResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		})
 	}
 	return err
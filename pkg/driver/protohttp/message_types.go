@@ -13,6 +13,8 @@ const (
 	authorizeResponseMessage              protobufMessageContentType = "AuthorizeResponse"
 	fieldResolveRequestMessage            protobufMessageContentType = "FieldResolveRequest"
 	fieldResolveResponseMessage           protobufMessageContentType = "FieldResolveResponse"
+	fieldResolveBatchRequestMessage       protobufMessageContentType = "FieldResolveBatchRequest"
+	fieldResolveBatchResponseMessage      protobufMessageContentType = "FieldResolveBatchResponse"
 	interfaceResolveTypeRequestMessage    protobufMessageContentType = "InterfaceResolveTypeRequest"
 	interfaceResolveTypeResponseMessage   protobufMessageContentType = "InterfaceResolveTypeResponse"
 	scalarParseRequestMessage             protobufMessageContentType = "ScalarParseRequest"
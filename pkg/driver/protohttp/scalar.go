@@ -2,6 +2,7 @@ package protohttp
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
@@ -9,14 +10,19 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// ScalarParse over http
+// ScalarParse calls ScalarParseContext with background context.
 func (c *Client) ScalarParse(input driver.ScalarParseInput) driver.ScalarParseOutput {
+	return c.ScalarParseContext(context.Background(), input)
+}
+
+// ScalarParseContext over http
+func (c *Client) ScalarParseContext(ctx context.Context, input driver.ScalarParseInput) driver.ScalarParseOutput {
 	var out driver.ScalarParseOutput
 	var body bytes.Buffer
 	err := protodriver.WriteScalarParseInput(&body, input)
 	if err == nil {
 		var b []byte
-		if b, err = c.do(message{
+		if b, err = c.do(ctx, message{
 			contentType:         scalarParseRequestMessage,
 			responseContentType: scalarParseResponseMessage,
 			b:                   body.Bytes(),
@@ -25,21 +31,24 @@ func (c *Client) ScalarParse(input driver.ScalarParseInput) driver.ScalarParseOu
 		}
 	}
 	if err != nil {
-		out.Error = &driver.Error{
-			Message: err.Error(),
-		}
+		out.Error = driverError(err)
 	}
 	return out
 }
 
-// ScalarSerialize over http
+// ScalarSerialize calls ScalarSerializeContext with background context.
 func (c *Client) ScalarSerialize(input driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
+	return c.ScalarSerializeContext(context.Background(), input)
+}
+
+// ScalarSerializeContext over http
+func (c *Client) ScalarSerializeContext(ctx context.Context, input driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
 	var out driver.ScalarSerializeOutput
 	var body bytes.Buffer
 	err := protodriver.WriteScalarSerializeInput(&body, input)
 	if err == nil {
 		var b []byte
-		if b, err = c.do(message{
+		if b, err = c.do(ctx, message{
 			contentType:         scalarSerializeRequestMessage,
 			responseContentType: scalarSerializeResponseMessage,
 			b:                   body.Bytes(),
@@ -48,9 +57,7 @@ func (c *Client) ScalarSerialize(input driver.ScalarSerializeInput) driver.Scala
 		}
 	}
 	if err != nil {
-		out.Error = &driver.Error{
-			Message: err.Error(),
-		}
+		out.Error = driverError(err)
 	}
 	return out
 }
@@ -70,9 +77,7 @@ func (h *Handler) scalarParse(req *http.Request, rw http.ResponseWriter) error {
 	}
 	if err != nil {
 		err = writeProto(rw, &protoMessages.ScalarParseResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		})
 	}
 	return err
@@ -93,9 +98,7 @@ func (h *Handler) scalarSerialize(req *http.Request, rw http.ResponseWriter) err
 	}
 	if err != nil {
 		err = writeProto(rw, &protoMessages.ScalarSerializeResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		})
 	}
 	return err
@@ -2,6 +2,7 @@ package protohttp
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
@@ -16,7 +17,7 @@ func (c *Client) SetSecrets(input driver.SetSecretsInput) driver.SetSecretsOutpu
 	err := protodriver.WriteSetSecretsInput(&body, input)
 	if err == nil {
 		var b []byte
-		if b, err = c.do(message{
+		if b, err = c.do(context.Background(), message{
 			contentType:         setSecretsRequestMessage,
 			responseContentType: setSecretsResponseMessage,
 			b:                   body.Bytes(),
@@ -25,9 +26,7 @@ func (c *Client) SetSecrets(input driver.SetSecretsInput) driver.SetSecretsOutpu
 		}
 	}
 	if err != nil {
-		out.Error = &driver.Error{
-			Message: err.Error(),
-		}
+		out.Error = driverError(err)
 	}
 	return out
 }
@@ -41,9 +40,7 @@ func (h *Handler) setSecrets(req *http.Request, rw http.ResponseWriter) error {
 	}
 	if err != nil {
 		err = writeProto(rw, &protoMessages.SetSecretsResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		})
 	}
 	return err
@@ -21,6 +21,12 @@ type Muxer interface {
 	SubscriptionConnection(driver.SubscriptionConnectionInput) (interface{}, error)
 }
 
+// ClaimsMuxer can be implemented by Muxer to return claims describing
+// identity of authorized request
+type ClaimsMuxer interface {
+	AuthorizeClaims(driver.AuthorizeInput) (bool, interface{}, error)
+}
+
 // ErrorLogger logs unrecoverable errors while handling request
 type ErrorLogger interface {
 	Error(err error)
@@ -89,6 +95,8 @@ func (h *Handler) serveHTTP(req *http.Request, rw http.ResponseWriter) error {
 		err = h.authorize(req, rw)
 	case string(fieldResolveRequestMessage):
 		err = h.fieldResolve(req, rw)
+	case string(fieldResolveBatchRequestMessage):
+		err = h.fieldResolveBatch(req, rw)
 	case string(interfaceResolveTypeRequestMessage):
 		err = h.interfaceResolveType(req, rw)
 	case string(setSecretsRequestMessage):
@@ -1,10 +1,20 @@
 package protohttp
 
-import "github.com/graphql-editor/stucco/pkg/driver"
+import (
+	"context"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+)
 
 // Stream implements driver.Stream. Currently protocol buffer streaming is not supported
 // over HTTP
-func (c *Client) Stream(driver.StreamInput) driver.StreamOutput {
+func (c *Client) Stream(input driver.StreamInput) driver.StreamOutput {
+	return c.StreamContext(context.Background(), input)
+}
+
+// StreamContext implements driver.ContextDriver. Currently protocol buffer streaming is not supported
+// over HTTP
+func (c *Client) StreamContext(context.Context, driver.StreamInput) driver.StreamOutput {
 	return driver.StreamOutput{
 		Error: &driver.Error{
 			Message: "HTTP transport does not support streaming",
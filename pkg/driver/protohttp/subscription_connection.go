@@ -2,6 +2,7 @@ package protohttp
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
@@ -9,14 +10,19 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// SubscriptionConnection implements driver.SubscriptionConnection over HTTP
+// SubscriptionConnection calls SubscriptionConnectionContext with background context.
 func (c *Client) SubscriptionConnection(input driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
+	return c.SubscriptionConnectionContext(context.Background(), input)
+}
+
+// SubscriptionConnectionContext implements driver.SubscriptionConnection over HTTP
+func (c *Client) SubscriptionConnectionContext(ctx context.Context, input driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
 	var out driver.SubscriptionConnectionOutput
 	var body bytes.Buffer
 	err := protodriver.WriteSubscriptionConnectionInput(&body, input)
 	if err == nil {
 		var b []byte
-		if b, err = c.do(message{
+		if b, err = c.do(ctx, message{
 			contentType:         subscriptionConnectionRequestMessage,
 			responseContentType: subscriptionConnectionResponseMessage,
 			b:                   body.Bytes(),
@@ -25,9 +31,7 @@ func (c *Client) SubscriptionConnection(input driver.SubscriptionConnectionInput
 		}
 	}
 	if err != nil {
-		out.Error = &driver.Error{
-			Message: err.Error(),
-		}
+		out.Error = driverError(err)
 	}
 	return out
 }
@@ -47,9 +51,7 @@ func (h *Handler) subscriptionConnection(req *http.Request, rw http.ResponseWrit
 	}
 	if err != nil {
 		err = writeProto(rw, &protoMessages.SubscriptionConnectionResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		})
 	}
 	return err
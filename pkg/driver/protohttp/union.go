@@ -2,6 +2,7 @@ package protohttp
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
@@ -9,14 +10,19 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// UnionResolveType over http
+// UnionResolveType calls UnionResolveTypeContext with background context.
 func (c *Client) UnionResolveType(input driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
+	return c.UnionResolveTypeContext(context.Background(), input)
+}
+
+// UnionResolveTypeContext over http
+func (c *Client) UnionResolveTypeContext(ctx context.Context, input driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
 	var out driver.UnionResolveTypeOutput
 	var body bytes.Buffer
 	err := protodriver.WriteUnionResolveTypeInput(&body, input)
 	if err == nil {
 		var b []byte
-		if b, err = c.do(message{
+		if b, err = c.do(ctx, message{
 			contentType:         unionResolveTypeRequestMessage,
 			responseContentType: unionResolveTypeResponseMessage,
 			b:                   body.Bytes(),
@@ -25,9 +31,7 @@ func (c *Client) UnionResolveType(input driver.UnionResolveTypeInput) driver.Uni
 		}
 	}
 	if err != nil {
-		out.Error = &driver.Error{
-			Message: err.Error(),
-		}
+		out.Error = driverError(err)
 	}
 	return out
 }
@@ -47,9 +51,7 @@ func (h *Handler) unionResolveType(req *http.Request, rw http.ResponseWriter) er
 	}
 	if err != nil {
 		err = writeProto(rw, &protoMessages.UnionResolveTypeResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		})
 	}
 	return err
@@ -11,9 +11,14 @@ type Config struct {
 	Runtime  string `json:"runtime,omitempty"`
 }
 
+// Factory creates a new driver for a user config. Drivers created by factory
+// are not shared, so that their users can configure them.
+type Factory func(c Config) Driver
+
 var (
-	lock    = sync.Mutex{}
-	drivers = map[Config]Driver{}
+	lock      = sync.Mutex{}
+	drivers   = map[Config]Driver{}
+	factories = map[string]Factory{}
 )
 
 // Register adds a new driver for a user config
@@ -23,10 +28,22 @@ func Register(c Config, d Driver) {
 	lock.Unlock()
 }
 
+// RegisterFactory adds a factory of drivers for a provider, used for
+// configs of provider without registered driver
+func RegisterFactory(provider string, f Factory) {
+	lock.Lock()
+	factories[provider] = f
+	lock.Unlock()
+}
+
 // GetDriver returns a driver matching user config for a runner
 func GetDriver(c Config) Driver {
 	lock.Lock()
-	d := drivers[c]
+	d, ok := drivers[c]
+	f := factories[c.Provider]
 	lock.Unlock()
+	if !ok && f != nil {
+		d = f(c)
+	}
 	return d
 }
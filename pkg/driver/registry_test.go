@@ -50,3 +50,17 @@ func TestRegistry(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryFactory(t *testing.T) {
+	driver.RegisterFactory("factory", func(c driver.Config) driver.Driver {
+		return new(drivertest.MockDriver)
+	})
+	d1 := driver.GetDriver(driver.Config{Provider: "factory"})
+	d2 := driver.GetDriver(driver.Config{Provider: "factory", Runtime: "runtime"})
+	assert.IsType(t, new(drivertest.MockDriver), d1)
+	assert.IsType(t, new(drivertest.MockDriver), d2)
+	assert.NotSame(t, d1, d2)
+	registered := new(drivertest.MockDriver)
+	driver.Register(driver.Config{Provider: "factory", Runtime: "registered"}, registered)
+	assert.Same(t, registered, driver.GetDriver(driver.Config{Provider: "factory", Runtime: "registered"}))
+}
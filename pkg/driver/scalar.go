@@ -5,6 +5,8 @@ import "github.com/graphql-editor/stucco/pkg/types"
 type ScalarParseInput struct {
 	Function types.Function `json:"function"`
 	Value    interface{}    `json:"value"`
+	// Claims returned by authorize function of a request
+	Claims interface{} `json:"claims,omitempty"`
 }
 type ScalarParseOutput struct {
 	Response interface{} `json:"response,omitempty"`
@@ -14,6 +16,8 @@ type ScalarParseOutput struct {
 type ScalarSerializeInput struct {
 	Function types.Function `json:"function"`
 	Value    interface{}    `json:"value"`
+	// Claims returned by authorize function of a request
+	Claims interface{} `json:"claims,omitempty"`
 }
 type ScalarSerializeOutput struct {
 	Response interface{} `json:"response,omitempty"`
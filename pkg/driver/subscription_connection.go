@@ -10,6 +10,8 @@ type SubscriptionConnectionInput struct {
 	OperationName  string                     `json:"operationName,omitempty"`
 	Protocol       interface{}                `json:"protocol,omitempty"`
 	Operation      *types.OperationDefinition `json:"operation,omitempty"`
+	// Claims returned by authorize function of a request
+	Claims interface{} `json:"claims,omitempty"`
 }
 
 // SubscriptionConnectionOutput represents response from a function which creates subscription connection data
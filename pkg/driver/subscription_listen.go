@@ -10,6 +10,8 @@ type SubscriptionListenInput struct {
 	OperationName  string                     `json:"operationName,omitempty"`
 	Protocol       interface{}                `json:"protocol,omitempty"`
 	Operation      *types.OperationDefinition `json:"operation,omitempty"`
+	// Claims returned by authorize function of a request
+	Claims interface{} `json:"claims,omitempty"`
 }
 
 // SubscriptionListenReader is a simple interface that listens for pings from backing function
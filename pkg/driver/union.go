@@ -15,6 +15,8 @@ type UnionResolveTypeInput struct {
 	Function types.Function
 	Value    interface{}
 	Info     UnionResolveTypeInfo
+	// Claims returned by authorize function of a request
+	Claims interface{} `json:"claims,omitempty"`
 }
 type UnionResolveTypeOutput struct {
 	Type  types.TypeRef
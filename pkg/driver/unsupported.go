@@ -0,0 +1,109 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+)
+
+// Unsupported implements ContextDriver returning an error from every call.
+// It can be embedded by drivers which implement only some of driver calls.
+type Unsupported struct{}
+
+func unsupported(call string) *Error {
+	return &Error{Message: fmt.Sprintf("%s is not supported by driver", call)}
+}
+
+// Authorize implements Driver
+func (u Unsupported) Authorize(AuthorizeInput) AuthorizeOutput {
+	return AuthorizeOutput{Error: unsupported("authorize")}
+}
+
+// AuthorizeContext implements ContextDriver
+func (u Unsupported) AuthorizeContext(_ context.Context, in AuthorizeInput) AuthorizeOutput {
+	return u.Authorize(in)
+}
+
+// FieldResolve implements Driver
+func (u Unsupported) FieldResolve(FieldResolveInput) FieldResolveOutput {
+	return FieldResolveOutput{Error: unsupported("field resolve")}
+}
+
+// FieldResolveContext implements ContextDriver
+func (u Unsupported) FieldResolveContext(_ context.Context, in FieldResolveInput) FieldResolveOutput {
+	return u.FieldResolve(in)
+}
+
+// InterfaceResolveType implements Driver
+func (u Unsupported) InterfaceResolveType(InterfaceResolveTypeInput) InterfaceResolveTypeOutput {
+	return InterfaceResolveTypeOutput{Error: unsupported("interface resolve type")}
+}
+
+// InterfaceResolveTypeContext implements ContextDriver
+func (u Unsupported) InterfaceResolveTypeContext(_ context.Context, in InterfaceResolveTypeInput) InterfaceResolveTypeOutput {
+	return u.InterfaceResolveType(in)
+}
+
+// ScalarParse implements Driver
+func (u Unsupported) ScalarParse(ScalarParseInput) ScalarParseOutput {
+	return ScalarParseOutput{Error: unsupported("scalar parse")}
+}
+
+// ScalarParseContext implements ContextDriver
+func (u Unsupported) ScalarParseContext(_ context.Context, in ScalarParseInput) ScalarParseOutput {
+	return u.ScalarParse(in)
+}
+
+// ScalarSerialize implements Driver
+func (u Unsupported) ScalarSerialize(ScalarSerializeInput) ScalarSerializeOutput {
+	return ScalarSerializeOutput{Error: unsupported("scalar serialize")}
+}
+
+// ScalarSerializeContext implements ContextDriver
+func (u Unsupported) ScalarSerializeContext(_ context.Context, in ScalarSerializeInput) ScalarSerializeOutput {
+	return u.ScalarSerialize(in)
+}
+
+// UnionResolveType implements Driver
+func (u Unsupported) UnionResolveType(UnionResolveTypeInput) UnionResolveTypeOutput {
+	return UnionResolveTypeOutput{Error: unsupported("union resolve type")}
+}
+
+// UnionResolveTypeContext implements ContextDriver
+func (u Unsupported) UnionResolveTypeContext(_ context.Context, in UnionResolveTypeInput) UnionResolveTypeOutput {
+	return u.UnionResolveType(in)
+}
+
+// SetSecrets implements Driver
+func (u Unsupported) SetSecrets(SetSecretsInput) SetSecretsOutput {
+	return SetSecretsOutput{Error: unsupported("set secrets")}
+}
+
+// Stream implements Driver
+func (u Unsupported) Stream(StreamInput) StreamOutput {
+	return StreamOutput{Error: unsupported("stream")}
+}
+
+// StreamContext implements ContextDriver
+func (u Unsupported) StreamContext(_ context.Context, in StreamInput) StreamOutput {
+	return u.Stream(in)
+}
+
+// SubscriptionConnection implements Driver
+func (u Unsupported) SubscriptionConnection(SubscriptionConnectionInput) SubscriptionConnectionOutput {
+	return SubscriptionConnectionOutput{Error: unsupported("subscription connection")}
+}
+
+// SubscriptionConnectionContext implements ContextDriver
+func (u Unsupported) SubscriptionConnectionContext(_ context.Context, in SubscriptionConnectionInput) SubscriptionConnectionOutput {
+	return u.SubscriptionConnection(in)
+}
+
+// SubscriptionListen implements Driver
+func (u Unsupported) SubscriptionListen(SubscriptionListenInput) SubscriptionListenOutput {
+	return SubscriptionListenOutput{Error: unsupported("subscription listen")}
+}
+
+// SubscriptionListenContext implements ContextDriver
+func (u Unsupported) SubscriptionListenContext(_ context.Context, in SubscriptionListenInput) SubscriptionListenOutput {
+	return u.SubscriptionListen(in)
+}
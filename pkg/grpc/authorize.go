@@ -9,19 +9,24 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// Authorize marshals a field resolution request through GRPC to a function
+// Authorize calls AuthorizeContext with background context.
+func (m *Client) Authorize(input driver.AuthorizeInput) driver.AuthorizeOutput {
+	return m.AuthorizeContext(context.Background(), input)
+}
+
+// AuthorizeContext marshals a field resolution request through GRPC to a function
 // that handles an actual resolution.
-func (m *Client) Authorize(input driver.AuthorizeInput) (f driver.AuthorizeOutput) {
+func (m *Client) AuthorizeContext(ctx context.Context, input driver.AuthorizeInput) (f driver.AuthorizeOutput) {
 	req, err := protodriver.MakeAuthorizeRequest(input)
 	if err == nil {
 		var resp *protoMessages.AuthorizeResponse
-		resp, err = m.Client.Authorize(context.Background(), req)
+		resp, err = m.Client.Authorize(ctx, req)
 		if err == nil {
 			f = protodriver.MakeAuthorizeOutput(resp)
 		}
 	}
 	if err != nil {
-		f.Error = &driver.Error{Message: err.Error()}
+		f.Error = clientError(err)
 	}
 	return
 }
@@ -41,6 +46,12 @@ func (f AuthorizeHandlerFunc) Handle(input driver.AuthorizeInput) (bool, error)
 	return f(input)
 }
 
+// AuthorizeClaimsHandler can be implemented by AuthorizeHandler to return claims
+// describing identity of authorized request.
+type AuthorizeClaimsHandler interface {
+	HandleClaims(input driver.AuthorizeInput) (bool, interface{}, error)
+}
+
 // Authorize function calls user implemented handler for field resolution
 func (m *Server) Authorize(ctx context.Context, input *protoMessages.AuthorizeRequest) (f *protoMessages.AuthorizeResponse, _ error) {
 	defer func() {
@@ -55,16 +66,19 @@ func (m *Server) Authorize(ctx context.Context, input *protoMessages.AuthorizeRe
 	req, err := protodriver.MakeAuthorizeInput(input)
 	if err == nil {
 		var resp bool
-		resp, err = m.AuthorizeHandler.Handle(req)
+		var claims interface{}
+		if h, ok := m.AuthorizeHandler.(AuthorizeClaimsHandler); ok {
+			resp, claims, err = h.HandleClaims(req)
+		} else {
+			resp, err = m.AuthorizeHandler.Handle(req)
+		}
 		if err == nil {
-			f = protodriver.MakeAuthorizeResponse(resp)
+			f, err = protodriver.MakeAuthorizeClaimsResponse(resp, claims)
 		}
 	}
 	if err != nil {
 		f = &protoMessages.AuthorizeResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		}
 	}
 	return
@@ -9,19 +9,24 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// FieldResolve marshals a field resolution request through GRPC to a function
+// FieldResolve calls FieldResolveContext with background context.
+func (m *Client) FieldResolve(input driver.FieldResolveInput) driver.FieldResolveOutput {
+	return m.FieldResolveContext(context.Background(), input)
+}
+
+// FieldResolveContext marshals a field resolution request through GRPC to a function
 // that handles an actual resolution.
-func (m *Client) FieldResolve(input driver.FieldResolveInput) (f driver.FieldResolveOutput) {
+func (m *Client) FieldResolveContext(ctx context.Context, input driver.FieldResolveInput) (f driver.FieldResolveOutput) {
 	req, err := protodriver.MakeFieldResolveRequest(input)
 	if err == nil {
 		var resp *protoMessages.FieldResolveResponse
-		resp, err = m.Client.FieldResolve(context.Background(), req)
+		resp, err = m.Client.FieldResolve(ctx, req)
 		if err == nil {
 			f = protodriver.MakeFieldResolveOutput(resp)
 		}
 	}
 	if err != nil {
-		f.Error = &driver.Error{Message: err.Error()}
+		f.Error = clientError(err)
 	}
 	return
 }
@@ -62,9 +67,7 @@ func (m *Server) FieldResolve(ctx context.Context, input *protoMessages.FieldRes
 	}
 	if err != nil {
 		f = &protoMessages.FieldResolveResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		}
 	}
 	return
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	protodriver "github.com/graphql-editor/stucco/pkg/proto/driver"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FieldResolveBatch calls FieldResolveBatchContext with background context.
+func (m *Client) FieldResolveBatch(input driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	return m.FieldResolveBatchContext(context.Background(), input)
+}
+
+// FieldResolveBatchContext marshals all field resolutions in batch through one GRPC call.
+// If server does not implement batches, each field is resolved with a separate call
+// for this and all following batches.
+func (m *Client) FieldResolveBatchContext(ctx context.Context, input driver.FieldResolveBatchInput) (out driver.FieldResolveBatchOutput) {
+	if atomic.LoadInt32(&m.batchUnimplemented) != 0 {
+		return driver.FieldResolveEach(ctx, m, input)
+	}
+	req, err := protodriver.MakeFieldResolveBatchRequest(input)
+	if err == nil {
+		var resp *protoMessages.FieldResolveBatchResponse
+		resp, err = m.Client.FieldResolveBatch(ctx, req)
+		if status.Code(err) == codes.Unimplemented {
+			atomic.StoreInt32(&m.batchUnimplemented, 1)
+			return driver.FieldResolveEach(ctx, m, input)
+		}
+		if err == nil {
+			out = protodriver.MakeFieldResolveBatchOutput(resp)
+		}
+	}
+	if err != nil {
+		out.Error = clientError(err)
+	}
+	return
+}
+
+// FieldResolveBatch function calls user implemented handler for each field resolution in batch
+func (m *Server) FieldResolveBatch(ctx context.Context, input *protoMessages.FieldResolveBatchRequest) (*protoMessages.FieldResolveBatchResponse, error) {
+	resps := make([]*protoMessages.FieldResolveResponse, 0, len(input.GetRequests()))
+	for _, req := range input.GetRequests() {
+		resp, _ := m.FieldResolve(ctx, req)
+		resps = append(resps, resp)
+	}
+	return &protoMessages.FieldResolveBatchResponse{
+		Responses: resps,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/grpc"
+	"github.com/graphql-editor/stucco/pkg/types"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func stringValue(s string) *protoMessages.Value {
+	return &protoMessages.Value{
+		TestValue: &protoMessages.Value_S{
+			S: s,
+		},
+	}
+}
+
+func TestClientFieldResolveBatch(t *testing.T) {
+	input := driver.FieldResolveBatchInput{
+		Inputs: []driver.FieldResolveInput{
+			{Function: types.Function{Name: "function"}, Source: "a"},
+			{Function: types.Function{Name: "function"}, Source: "b"},
+		},
+	}
+	isSource := func(s string) interface{} {
+		return mock.MatchedBy(func(req *protoMessages.FieldResolveRequest) bool {
+			return req.GetSource().GetS() == s
+		})
+	}
+	t.Run("SendsBatch", func(t *testing.T) {
+		driverClientMock := new(driverClientMock)
+		driverClientMock.On(
+			"FieldResolveBatch",
+			mock.Anything,
+			mock.MatchedBy(func(req *protoMessages.FieldResolveBatchRequest) bool {
+				return len(req.Requests) == 2 &&
+					req.Requests[0].GetSource().GetS() == "a" &&
+					req.Requests[1].GetSource().GetS() == "b"
+			}),
+		).Return(&protoMessages.FieldResolveBatchResponse{
+			Responses: []*protoMessages.FieldResolveResponse{
+				{Response: stringValue("response a")},
+				{Error: &protoMessages.Error{Msg: "error b"}},
+			},
+		}, nil)
+		client := grpc.Client{
+			Client: driverClientMock,
+		}
+		out := client.FieldResolveBatch(input)
+		assert.Equal(t, driver.FieldResolveBatchOutput{
+			Outputs: []driver.FieldResolveOutput{
+				{Response: "response a"},
+				{Error: &driver.Error{Message: "error b"}},
+			},
+		}, out)
+		driverClientMock.AssertNotCalled(t, "FieldResolve", mock.Anything, mock.Anything)
+	})
+	t.Run("ReturnsBatchError", func(t *testing.T) {
+		driverClientMock := new(driverClientMock)
+		driverClientMock.On("FieldResolveBatch", mock.Anything, mock.Anything).Return(&protoMessages.FieldResolveBatchResponse{
+			Error: &protoMessages.Error{Msg: "batch error"},
+		}, nil)
+		client := grpc.Client{
+			Client: driverClientMock,
+		}
+		out := client.FieldResolveBatch(input)
+		assert.Equal(t, driver.FieldResolveBatchOutput{
+			Error: &driver.Error{Message: "batch error"},
+		}, out)
+	})
+	t.Run("FallsBackToFieldResolve", func(t *testing.T) {
+		driverClientMock := new(driverClientMock)
+		driverClientMock.On("FieldResolveBatch", mock.Anything, mock.Anything).Return(
+			nil,
+			status.Error(codes.Unimplemented, "method FieldResolveBatch not implemented"),
+		).Once()
+		driverClientMock.On("FieldResolve", mock.Anything, isSource("a")).Return(&protoMessages.FieldResolveResponse{
+			Response: stringValue("response a"),
+		}, nil)
+		driverClientMock.On("FieldResolve", mock.Anything, isSource("b")).Return(&protoMessages.FieldResolveResponse{
+			Response: stringValue("response b"),
+		}, nil)
+		client := grpc.Client{
+			Client: driverClientMock,
+		}
+		expected := driver.FieldResolveBatchOutput{
+			Outputs: []driver.FieldResolveOutput{
+				{Response: "response a"},
+				{Response: "response b"},
+			},
+		}
+		assert.Equal(t, expected, client.FieldResolveBatch(input))
+		// server without batches is not asked again
+		assert.Equal(t, expected, client.FieldResolveBatch(input))
+		driverClientMock.AssertNumberOfCalls(t, "FieldResolveBatch", 1)
+		driverClientMock.AssertNumberOfCalls(t, "FieldResolve", 4)
+	})
+}
+
+func TestServerFieldResolveBatch(t *testing.T) {
+	fieldResolveMock := new(fieldResolveMock)
+	fieldResolveMock.On("Handle", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Source == "a"
+	})).Return("response a", nil)
+	fieldResolveMock.On("Handle", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Source == "b"
+	})).Return(nil, errors.New("error b"))
+	srv := grpc.Server{
+		FieldResolveHandler: fieldResolveMock,
+	}
+	resp, err := srv.FieldResolveBatch(context.Background(), &protoMessages.FieldResolveBatchRequest{
+		Requests: []*protoMessages.FieldResolveRequest{
+			{Function: &protoMessages.Function{Name: "function"}, Source: stringValue("a")},
+			{Function: &protoMessages.Function{Name: "function"}, Source: stringValue("b")},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &protoMessages.FieldResolveBatchResponse{
+		Responses: []*protoMessages.FieldResolveResponse{
+			{Response: stringValue("response a")},
+			{Error: &protoMessages.Error{Msg: "error b"}},
+		},
+	}, resp)
+}
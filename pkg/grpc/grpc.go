@@ -4,11 +4,24 @@ import (
 	"github.com/graphql-editor/stucco/pkg/driver"
 	protoDriverService "github.com/graphql-editor/stucco_proto/go/driver_service"
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Client for github.com/graphql-editor/stucco/pkg/proto
 type Client struct {
 	Client protoDriverService.DriverClient
+	// set when server does not implement FieldResolveBatch
+	batchUnimplemented int32
+}
+
+// clientError converts error from a call to server into driver error
+func clientError(err error) *driver.Error {
+	derr := &driver.Error{Message: err.Error()}
+	if status.Code(err) == codes.Unavailable {
+		derr.Code = driver.ErrorCodeUnavailable
+	}
+	return derr
 }
 
 // StdoutHandler interface that must be implemented by user for handling
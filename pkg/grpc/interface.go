@@ -9,18 +9,23 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// InterfaceResolveType handles type resolution for interface through GRPC
-func (m *Client) InterfaceResolveType(input driver.InterfaceResolveTypeInput) (i driver.InterfaceResolveTypeOutput) {
+// InterfaceResolveType calls InterfaceResolveTypeContext with background context.
+func (m *Client) InterfaceResolveType(input driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
+	return m.InterfaceResolveTypeContext(context.Background(), input)
+}
+
+// InterfaceResolveTypeContext handles type resolution for interface through GRPC
+func (m *Client) InterfaceResolveTypeContext(ctx context.Context, input driver.InterfaceResolveTypeInput) (i driver.InterfaceResolveTypeOutput) {
 	req, err := protodriver.MakeInterfaceResolveTypeRequest(input)
 	if err == nil {
 		var resp *protoMessages.InterfaceResolveTypeResponse
-		resp, err = m.Client.InterfaceResolveType(context.Background(), req)
+		resp, err = m.Client.InterfaceResolveType(ctx, req)
 		if err == nil {
 			i = protodriver.MakeInterfaceResolveTypeOutput(resp)
 		}
 	}
 	if err != nil {
-		i.Error = &driver.Error{Message: err.Error()}
+		i.Error = clientError(err)
 		err = nil
 	}
 	return
@@ -63,7 +68,7 @@ func (m *Server) InterfaceResolveType(ctx context.Context, input *protoMessages.
 	}
 	if err != nil {
 		f = &protoMessages.InterfaceResolveTypeResponse{
-			Error: &protoMessages.Error{Msg: err.Error()},
+			Error: protodriver.MakeProtoError(err),
 		}
 	}
 	return
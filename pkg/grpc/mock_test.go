@@ -45,6 +45,15 @@ func (m *driverClientMock) FieldResolve(ctx context.Context, in *protoMessages.F
 	return resp.(*protoMessages.FieldResolveResponse), called.Error(1)
 }
 
+func (m *driverClientMock) FieldResolveBatch(ctx context.Context, in *protoMessages.FieldResolveBatchRequest, opts ...googlegrpc.CallOption) (*protoMessages.FieldResolveBatchResponse, error) {
+	called := m.Called(concatOpts(ctx, in, opts...)...)
+	resp := called.Get(0)
+	if resp == nil {
+		return nil, called.Error(1)
+	}
+	return resp.(*protoMessages.FieldResolveBatchResponse), called.Error(1)
+}
+
 func (m *driverClientMock) InterfaceResolveType(ctx context.Context, in *protoMessages.InterfaceResolveTypeRequest, opts ...googlegrpc.CallOption) (*protoMessages.InterfaceResolveTypeResponse, error) {
 	called := m.Called(concatOpts(ctx, in, opts...)...)
 	resp := called.Get(0)
@@ -9,34 +9,44 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// ScalarParse executes server side ScalarParse rpc
-func (m *Client) ScalarParse(input driver.ScalarParseInput) (s driver.ScalarParseOutput) {
+// ScalarParse calls ScalarParseContext with background context.
+func (m *Client) ScalarParse(input driver.ScalarParseInput) driver.ScalarParseOutput {
+	return m.ScalarParseContext(context.Background(), input)
+}
+
+// ScalarParseContext executes server side ScalarParse rpc
+func (m *Client) ScalarParseContext(ctx context.Context, input driver.ScalarParseInput) (s driver.ScalarParseOutput) {
 	req, err := protodriver.MakeScalarParseRequest(input)
 	if err == nil {
 		var resp *protoMessages.ScalarParseResponse
-		resp, err = m.Client.ScalarParse(context.Background(), req)
+		resp, err = m.Client.ScalarParse(ctx, req)
 		if err == nil {
 			s = protodriver.MakeScalarParseOutput(resp)
 		}
 	}
 	if err != nil {
-		s.Error = &driver.Error{Message: err.Error()}
+		s.Error = clientError(err)
 	}
 	return
 }
 
-// ScalarSerialize executes server side ScalarSerialize rpc
-func (m *Client) ScalarSerialize(input driver.ScalarSerializeInput) (s driver.ScalarSerializeOutput) {
+// ScalarSerialize calls ScalarSerializeContext with background context.
+func (m *Client) ScalarSerialize(input driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
+	return m.ScalarSerializeContext(context.Background(), input)
+}
+
+// ScalarSerializeContext executes server side ScalarSerialize rpc
+func (m *Client) ScalarSerializeContext(ctx context.Context, input driver.ScalarSerializeInput) (s driver.ScalarSerializeOutput) {
 	req, err := protodriver.MakeScalarSerializeRequest(input)
 	if err == nil {
 		var resp *protoMessages.ScalarSerializeResponse
-		resp, err = m.Client.ScalarSerialize(context.Background(), req)
+		resp, err = m.Client.ScalarSerialize(ctx, req)
 		if err == nil {
 			s = protodriver.MakeScalarSerializeOutput(resp)
 		}
 	}
 	if err != nil {
-		s.Error = &driver.Error{Message: err.Error()}
+		s.Error = clientError(err)
 		err = nil
 	}
 	return
@@ -79,7 +89,7 @@ func (m *Server) ScalarParse(ctx context.Context, input *protoMessages.ScalarPar
 	}
 	if err != nil {
 		s = &protoMessages.ScalarParseResponse{
-			Error: &protoMessages.Error{Msg: err.Error()},
+			Error: protodriver.MakeProtoError(err),
 		}
 	}
 	return
@@ -121,7 +131,7 @@ func (m *Server) ScalarSerialize(ctx context.Context, input *protoMessages.Scala
 	}
 	if err != nil {
 		s = &protoMessages.ScalarSerializeResponse{
-			Error: &protoMessages.Error{Msg: err.Error()},
+			Error: protodriver.MakeProtoError(err),
 		}
 	}
 	return
@@ -19,7 +19,7 @@ func (m *Client) SetSecrets(input driver.SetSecretsInput) driver.SetSecretsOutpu
 		out = protodriver.MakeSetSecretsOutput(resp)
 	}
 	if err != nil {
-		out.Error = &driver.Error{Message: err.Error()}
+		out.Error = clientError(err)
 	}
 	return out
 }
@@ -1,18 +1,30 @@
 package grpc
 
 import (
+	"context"
+
 	"github.com/graphql-editor/stucco/pkg/driver"
+	protodriver "github.com/graphql-editor/stucco/pkg/proto/driver"
 	protoDriverService "github.com/graphql-editor/stucco_proto/go/driver_service"
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// Stream TODO: client side stream requests
-func (m *Client) Stream(input driver.StreamInput) (s driver.StreamOutput) {
-	return driver.StreamOutput{
-		Error: &driver.Error{
-			Message: "Streaming not yet implemented",
-		},
+// Stream calls StreamContext with background context.
+func (m *Client) Stream(input driver.StreamInput) driver.StreamOutput {
+	return m.StreamContext(context.Background(), input)
+}
+
+// StreamContext opens a stream with a function through GRPC and returns a reader
+// of stream messages. Reader is closed when context is done.
+func (m *Client) StreamContext(ctx context.Context, input driver.StreamInput) (s driver.StreamOutput) {
+	req, err := protodriver.MakeStreamRequest(input)
+	if err == nil {
+		s.Reader, err = protodriver.NewStreamReaderContext(ctx, m.Client, req)
+	}
+	if err != nil {
+		s.Error = clientError(err)
 	}
+	return
 }
 
 // StreamHandler interface must be implemented by user to handle stream requests from subscriptions
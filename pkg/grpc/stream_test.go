@@ -0,0 +1,112 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/grpc"
+	"github.com/graphql-editor/stucco/pkg/proto/prototest"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	googlegrpc "google.golang.org/grpc"
+)
+
+type streamClientMock struct {
+	googlegrpc.ClientStream
+	ctx      context.Context
+	messages []*protoMessages.StreamMessage
+	err      error
+}
+
+func (m *streamClientMock) Recv() (*protoMessages.StreamMessage, error) {
+	if len(m.messages) > 0 {
+		msg := m.messages[0]
+		m.messages = m.messages[1:]
+		return msg, nil
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	<-m.ctx.Done()
+	return nil, m.ctx.Err()
+}
+
+func TestClientStream(t *testing.T) {
+	prototest.RunStreamClientTests(t, func(t *testing.T, tt prototest.StreamClientTest) {
+		streamMock := &streamClientMock{
+			messages: tt.ProtoMessages,
+			err:      tt.ProtoError,
+		}
+		if streamMock.err == nil {
+			streamMock.err = io.EOF
+		}
+		driverClientMock := new(driverClientMock)
+		driverClientMock.On(
+			"Stream",
+			mock.Anything,
+			tt.ProtoRequest,
+		).Run(func(args mock.Arguments) {
+			streamMock.ctx = args.Get(0).(context.Context)
+		}).Return(streamMock, nil)
+		client := grpc.Client{
+			Client: driverClientMock,
+		}
+		out := client.Stream(tt.Input)
+		assert.Nil(t, out.Error)
+		var messages []driver.StreamMessage
+		for out.Reader.Next() {
+			messages = append(messages, out.Reader.Read())
+		}
+		assert.Equal(t, tt.Expected, messages)
+		assert.Equal(t, tt.ExpectedError, out.Reader.Error())
+		out.Reader.Close()
+		driverClientMock.AssertCalled(t, "Stream", mock.Anything, tt.ProtoRequest)
+	})
+	t.Run("ReturnsOpenError", func(t *testing.T) {
+		driverClientMock := new(driverClientMock)
+		driverClientMock.On("Stream", mock.Anything, mock.Anything).Return(nil, errors.New("open error"))
+		client := grpc.Client{
+			Client: driverClientMock,
+		}
+		out := client.Stream(driver.StreamInput{})
+		assert.Nil(t, out.Reader)
+		assert.Equal(t, &driver.Error{Message: "open error"}, out.Error)
+	})
+	t.Run("CloseCancelsStream", func(t *testing.T) {
+		streamMock := new(streamClientMock)
+		driverClientMock := new(driverClientMock)
+		driverClientMock.On("Stream", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			streamMock.ctx = args.Get(0).(context.Context)
+		}).Return(streamMock, nil)
+		client := grpc.Client{
+			Client: driverClientMock,
+		}
+		out := client.Stream(driver.StreamInput{})
+		assert.Nil(t, out.Error)
+		out.Reader.Close()
+		assert.False(t, out.Reader.Next())
+		assert.NoError(t, out.Reader.Error())
+		assert.Error(t, streamMock.ctx.Err())
+	})
+	t.Run("ContextCancelsStream", func(t *testing.T) {
+		streamMock := new(streamClientMock)
+		driverClientMock := new(driverClientMock)
+		driverClientMock.On("Stream", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			streamMock.ctx = args.Get(0).(context.Context)
+		}).Return(streamMock, nil)
+		client := grpc.Client{
+			Client: driverClientMock,
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		out := client.StreamContext(ctx, driver.StreamInput{})
+		assert.Nil(t, out.Error)
+		cancel()
+		assert.False(t, out.Reader.Next())
+		assert.NoError(t, out.Reader.Error())
+		out.Reader.Close()
+	})
+}
@@ -9,19 +9,24 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// SubscriptionConnection marshals a field resolution request through GRPC to a function
+// SubscriptionConnection calls SubscriptionConnectionContext with background context.
+func (m *Client) SubscriptionConnection(input driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
+	return m.SubscriptionConnectionContext(context.Background(), input)
+}
+
+// SubscriptionConnectionContext marshals a field resolution request through GRPC to a function
 // that handles an actual resolution.
-func (m *Client) SubscriptionConnection(input driver.SubscriptionConnectionInput) (f driver.SubscriptionConnectionOutput) {
+func (m *Client) SubscriptionConnectionContext(ctx context.Context, input driver.SubscriptionConnectionInput) (f driver.SubscriptionConnectionOutput) {
 	req, err := protodriver.MakeSubscriptionConnectionRequest(input)
 	if err == nil {
 		var resp *protoMessages.SubscriptionConnectionResponse
-		resp, err = m.Client.SubscriptionConnection(context.Background(), req)
+		resp, err = m.Client.SubscriptionConnection(ctx, req)
 		if err == nil {
 			f = protodriver.MakeSubscriptionConnectionOutput(resp)
 		}
 	}
 	if err != nil {
-		f.Error = &driver.Error{Message: err.Error()}
+		f.Error = clientError(err)
 	}
 	return
 }
@@ -62,9 +67,7 @@ func (m *Server) SubscriptionConnection(ctx context.Context, input *protoMessage
 	}
 	if err != nil {
 		s = &protoMessages.SubscriptionConnectionResponse{
-			Error: &protoMessages.Error{
-				Msg: err.Error(),
-			},
+			Error: protodriver.MakeProtoError(err),
 		}
 	}
 	return
@@ -1,20 +1,28 @@
 package grpc
 
 import (
+	"context"
+
 	"github.com/graphql-editor/stucco/pkg/driver"
 	protodriver "github.com/graphql-editor/stucco/pkg/proto/driver"
 	protoDriverService "github.com/graphql-editor/stucco_proto/go/driver_service"
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// SubscriptionListen returns a subscription event reader.
-func (m *Client) SubscriptionListen(input driver.SubscriptionListenInput) (out driver.SubscriptionListenOutput) {
+// SubscriptionListen calls SubscriptionListenContext with background context.
+func (m *Client) SubscriptionListen(input driver.SubscriptionListenInput) driver.SubscriptionListenOutput {
+	return m.SubscriptionListenContext(context.Background(), input)
+}
+
+// SubscriptionListenContext returns a subscription event reader. Reader is closed
+// when context is done.
+func (m *Client) SubscriptionListenContext(ctx context.Context, input driver.SubscriptionListenInput) (out driver.SubscriptionListenOutput) {
 	req, err := protodriver.MakeSubscriptionListenRequest(input)
 	if err == nil {
-		out.Reader, err = protodriver.NewSubscriptionReader(m.Client, req)
+		out.Reader, err = protodriver.NewSubscriptionReaderContext(ctx, m.Client, req)
 	}
 	if err != nil {
-		out.Error = &driver.Error{Message: err.Error()}
+		out.Error = clientError(err)
 	}
 	return
 }
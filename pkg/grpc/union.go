@@ -9,18 +9,23 @@ import (
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 )
 
-// UnionResolveType Implements driver.Driver
-func (m *Client) UnionResolveType(input driver.UnionResolveTypeInput) (f driver.UnionResolveTypeOutput) {
+// UnionResolveType calls UnionResolveTypeContext with background context.
+func (m *Client) UnionResolveType(input driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
+	return m.UnionResolveTypeContext(context.Background(), input)
+}
+
+// UnionResolveTypeContext Implements driver.Driver
+func (m *Client) UnionResolveTypeContext(ctx context.Context, input driver.UnionResolveTypeInput) (f driver.UnionResolveTypeOutput) {
 	req, err := protodriver.MakeUnionResolveTypeRequest(input)
 	if err == nil {
 		var resp *protoMessages.UnionResolveTypeResponse
-		resp, err = m.Client.UnionResolveType(context.Background(), req)
+		resp, err = m.Client.UnionResolveType(ctx, req)
 		if err == nil {
 			f = protodriver.MakeUnionResolveTypeOutput(resp)
 		}
 	}
 	if err != nil {
-		f.Error = &driver.Error{Message: err.Error()}
+		f.Error = clientError(err)
 	}
 	return
 }
@@ -62,7 +67,7 @@ func (m *Server) UnionResolveType(ctx context.Context, input *protoMessages.Unio
 	}
 	if err != nil {
 		f = &protoMessages.UnionResolveTypeResponse{
-			Error: &protoMessages.Error{Msg: err.Error()},
+			Error: protodriver.MakeProtoError(err),
 		}
 	}
 	return
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/graphql-go/handler"
+)
+
+const (
+	// InvalidBatch is an error code returned when batched request cannot be executed
+	InvalidBatch = "INVALID_BATCH"
+	// BatchTooLarge is an error code returned when batch has more operations than allowed
+	BatchTooLarge = "BATCH_TOO_LARGE"
+)
+
+const (
+	// DefaultMaxBatchSize is a default maximum number of operations in batched request
+	DefaultMaxBatchSize = 10
+	// DefaultBatchParallelism is a default number of operations of batch executed concurrently
+	DefaultBatchParallelism = 4
+)
+
+// batchRequestOptions returns operations of a request with JSON array body. Body
+// of request that is not batched is left intact.
+func batchRequestOptions(req *http.Request) ([]*requestOptions, bool, error) {
+	if req.Method != http.MethodPost || req.Body == nil {
+		return nil, false, nil
+	}
+	contentType := strings.TrimSpace(strings.Split(req.Header.Get("Content-Type"), ";")[0])
+	if contentType != handler.ContentTypeJSON && contentType != "" {
+		return nil, false, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if trimmed := bytes.TrimSpace(body); len(trimmed) == 0 || trimmed[0] != '[' {
+		return nil, false, nil
+	}
+	var batch []*requestOptions
+	if err := json.Unmarshal(body, &batch); err != nil {
+		return nil, true, err
+	}
+	return batch, true, nil
+}
+
+// serveBatch executes operations of batched request and writes their results
+// in order of operations
+func (h *Handler) serveBatch(rw http.ResponseWriter, req *http.Request, batch []*requestOptions) {
+	maxSize := h.maxBatchSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxBatchSize
+	}
+	switch {
+	case maxSize < 0:
+		perr := requestError{message: "batched requests are not supported", code: InvalidBatch, status: http.StatusBadRequest}
+		h.writeResult(rw, perr.status, perr.result())
+		return
+	case len(batch) == 0:
+		perr := requestError{message: "batch is empty", code: InvalidBatch, status: http.StatusBadRequest}
+		h.writeResult(rw, perr.status, perr.result())
+		return
+	case len(batch) > maxSize:
+		perr := requestError{
+			message: fmt.Sprintf("batch has %d operations, maximum is %d", len(batch), maxSize),
+			code:    BatchTooLarge,
+			status:  http.StatusBadRequest,
+		}
+		h.writeResult(rw, perr.status, perr.result())
+		return
+	}
+	parallelism := h.batchParallelism
+	if parallelism <= 0 {
+		parallelism = DefaultBatchParallelism
+	}
+	results := make([]*graphql.Result, len(batch))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i := range batch {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = h.executeBatched(req, batch[i])
+		}(i)
+	}
+	wg.Wait()
+	h.writeJSON(rw, http.StatusOK, results)
+}
+
+// executeBatched executes one operation of batch, each operation is checked and
+// authorized separately
+func (h *Handler) executeBatched(req *http.Request, opts *requestOptions) *graphql.Result {
+	if opts == nil {
+		opts = &requestOptions{}
+	}
+	if perr := h.checkRequest(opts); perr != nil {
+		return perr.result()
+	}
+	if isSubscriptionOperation(opts.Query, opts.OperationName) {
+		return errSubscriptionInBatch.result()
+	}
+	ctx := req.Context()
+	if opts.RawSubscription {
+		ctx = context.WithValue(ctx, router.RawSubscriptionKey, true)
+	}
+	if opts.SubscriptionPayload != "" {
+		ctx = context.WithValue(ctx, router.SubscriptionPayloadKey, opts.SubscriptionPayload)
+	}
+	var rootObject map[string]interface{}
+	if h.rootObjectFn != nil {
+		rootObject = h.rootObjectFn(ctx, req)
+	}
+	result, sub := h.execute(ctx, opts, rootObject)
+	_, redirect := result.Extensions["subscriptionRedirect"]
+	if sub != nil {
+		sub.sub.Reader.Close()
+	}
+	if sub != nil || (redirect && len(result.Errors) == 0) {
+		return errSubscriptionInBatch.result()
+	}
+	return result
+}
+
+var errSubscriptionInBatch = requestError{
+	message: "subscriptions are not supported in batched requests",
+	code:    InvalidBatch,
+}
+
+// isSubscriptionOperation returns true if operation executed by query is a subscription.
+// Queries that cannot be parsed are left for executor to report.
+func isSubscriptionOperation(query, operationName string) bool {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(query)}),
+	})
+	if err != nil {
+		return false
+	}
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName == "" {
+			if operation != nil {
+				return false
+			}
+			operation = op
+		} else if op.Name != nil && op.Name.Value == operationName {
+			operation = op
+		}
+	}
+	return operation != nil && operation.Operation == ast.OperationTypeSubscription
+}
@@ -0,0 +1,145 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerBatch(t *testing.T) {
+	env := router.Environment{
+		Provider: "batch",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("Authorize", mock.MatchedBy(func(in driver.AuthorizeInput) bool {
+		return in.OperationName != "Forbidden"
+	})).Return(driver.AuthorizeOutput{Response: true})
+	mockDriver.On("Authorize", mock.MatchedBy(func(in driver.AuthorizeInput) bool {
+		return in.OperationName == "Forbidden"
+	})).Return(driver.AuthorizeOutput{Response: false})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "hello"
+	})).Return(driver.FieldResolveOutput{Response: "world"})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Authorize: &router.AuthorizeConfig{
+			Authorize: types.Function{Name: "authorize"},
+		},
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.hello": {
+				Resolve: types.Function{Name: "hello"},
+			},
+		},
+		Schema: `type Query {
+			hello: String
+		}
+		type Subscription {
+			onHello: String
+		}
+		schema {
+			query: Query
+			subscription: Subscription
+		}`,
+	})
+	require.NoError(t, err)
+	data := []struct {
+		title    string
+		cfg      handlers.Config
+		body     string
+		status   int
+		expected string
+	}{
+		{
+			title: "ReturnsOrderedResults",
+			body: `[
+				{"query":"query Allowed { hello }","operationName":"Allowed"},
+				{"query":"query Forbidden { hello }","operationName":"Forbidden"},
+				{"query":"{ hello }"}
+			]`,
+			status: http.StatusOK,
+			expected: `[
+				{"data":{"hello":"world"}},
+				{"data":null,"errors":[{"message":"unauthorized","locations":[]}]},
+				{"data":{"hello":"world"}}
+			]`,
+		},
+		{
+			title:    "RunsSequentially",
+			cfg:      handlers.Config{BatchParallelism: 1},
+			body:     `[{"query":"{ hello }"},{"query":"{ hello }"}]`,
+			status:   http.StatusOK,
+			expected: `[{"data":{"hello":"world"}},{"data":{"hello":"world"}}]`,
+		},
+		{
+			title:    "RejectsTooLargeBatch",
+			cfg:      handlers.Config{MaxBatchSize: 1},
+			body:     `[{"query":"{ hello }"},{"query":"{ hello }"}]`,
+			status:   http.StatusBadRequest,
+			expected: `{"data":null,"errors":[{"message":"batch has 2 operations, maximum is 1","locations":null,"extensions":{"code":"BATCH_TOO_LARGE"}}]}`,
+		},
+		{
+			title:    "RejectsEmptyBatch",
+			body:     `[]`,
+			status:   http.StatusBadRequest,
+			expected: `{"data":null,"errors":[{"message":"batch is empty","locations":null,"extensions":{"code":"INVALID_BATCH"}}]}`,
+		},
+		{
+			title: "RejectsSubscriptions",
+			body: `[
+				{"query":"subscription { onHello }"},
+				{"query":"query Q { hello } subscription S { onHello }","operationName":"S"},
+				{"query":"query Q { hello } subscription S { onHello }","operationName":"Q"}
+			]`,
+			status: http.StatusOK,
+			expected: `[
+				{"data":null,"errors":[{"message":"subscriptions are not supported in batched requests","locations":null,"extensions":{"code":"INVALID_BATCH"}}]},
+				{"data":null,"errors":[{"message":"subscriptions are not supported in batched requests","locations":null,"extensions":{"code":"INVALID_BATCH"}}]},
+				{"data":{"hello":"world"}}
+			]`,
+		},
+		{
+			title:    "ExecutesSingleOperation",
+			body:     `{"query":"{ hello }"}`,
+			status:   http.StatusOK,
+			expected: `{"data":{"hello":"world"}}`,
+		},
+	}
+	for i := range data {
+		tt := data[i]
+		t.Run(tt.title, func(t *testing.T) {
+			tt.cfg.Schema = &rt.Schema
+			h := handlers.New(tt.cfg)
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(t, tt.status, rec.Code)
+			b, err := ioutil.ReadAll(rec.Body)
+			require.NoError(t, err)
+			var expected, actual interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.expected), &expected))
+			require.NoError(t, json.Unmarshal(b, &actual))
+			assert.Equal(t, expected, actual)
+		})
+	}
+	mockDriver.AssertNotCalled(t, "SubscriptionConnection", mock.Anything)
+	mockDriver.AssertNotCalled(t, "SubscriptionListen", mock.Anything)
+}
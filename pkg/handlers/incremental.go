@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"sync"
+
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+// incrementalBoundary is a boundary of multipart/mixed response parts
+const incrementalBoundary = "-"
+
+// incrementalPayload is a part of incremental response
+type incrementalPayload struct {
+	Data        interface{}                `json:"data,omitempty"`
+	Errors      []gqlerrors.FormattedError `json:"errors,omitempty"`
+	Incremental []router.IncrementalResult `json:"incremental,omitempty"`
+	HasNext     bool                       `json:"hasNext"`
+}
+
+func acceptsMultipartMixed(req *http.Request) bool {
+	return strings.Contains(req.Header.Get("Accept"), "multipart/mixed")
+}
+
+// multipartWriter writes parts of multipart/mixed response
+type multipartWriter struct {
+	w       *multipart.Writer
+	flusher http.Flusher
+}
+
+func newMultipartWriter(rw http.ResponseWriter) (*multipartWriter, bool) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+	w := multipart.NewWriter(rw)
+	w.SetBoundary(incrementalBoundary)
+	rw.Header().Set("Content-Type", `multipart/mixed; boundary="`+incrementalBoundary+`"`)
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	return &multipartWriter{w: w, flusher: flusher}, true
+}
+
+func (m *multipartWriter) write(payload incrementalPayload) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	part, err := m.w.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json; charset=utf-8"}})
+	if err == nil {
+		_, err = part.Write(b)
+	}
+	if err == nil {
+		m.flusher.Flush()
+	}
+	return err
+}
+
+func (m *multipartWriter) close() {
+	m.w.Close()
+	m.flusher.Flush()
+}
+
+// serveIncremental delivers result of operation using @defer or @stream directives in
+// multipart/mixed response, returns false if operation does not use them
+func (h *Handler) serveIncremental(ctx context.Context, rw http.ResponseWriter, opts *requestOptions, rootObject map[string]interface{}) bool {
+	operation, ok := router.SplitIncremental(*h.Schema, opts.Query, opts.OperationName, opts.Variables)
+	if !ok {
+		return false
+	}
+	w, ok := newMultipartWriter(rw)
+	if !ok {
+		return false
+	}
+	defer w.close()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	execute := func(query string, streams *router.Streams, deferred *router.Deferred) *graphql.Result {
+		pctx, cancel := context.WithTimeout(router.WithDeferred(router.WithStreams(ctx, streams), deferred), h.requestTimeout)
+		defer cancel()
+		return graphql.Do(graphql.Params{
+			Schema:         *h.Schema,
+			RequestString:  query,
+			VariableValues: opts.Variables,
+			OperationName:  opts.OperationName,
+			Context:        pctx,
+			RootObject:     rootObject,
+		})
+	}
+	payloads := make(chan incrementalPayload)
+	send := func(p incrementalPayload) bool {
+		select {
+		case payloads <- p:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	closeStreams := func(streams *router.Streams) {
+		for _, s := range streams.Take() {
+			s.Close()
+		}
+	}
+	var wg sync.WaitGroup
+	// stream sends items of streamed lists of operation
+	stream := func(streams *router.Streams) {
+		for _, s := range streams.Take() {
+			wg.Add(1)
+			go func(s *router.Stream) {
+				defer wg.Done()
+				done := make(chan struct{})
+				defer close(done)
+				go func() {
+					select {
+					case <-ctx.Done():
+					case <-done:
+					}
+					s.Close()
+				}()
+				for {
+					result, ok := s.Next()
+					if !ok || !send(incrementalPayload{Incremental: []router.IncrementalResult{result}, HasNext: true}) {
+						return
+					}
+				}
+			}(s)
+		}
+	}
+	// deferFragments executes fragments deferred on resolved values concurrently,
+	// their results are sent after result in which they were deferred is sent
+	var deferFragments func(sent <-chan struct{}, fragments []*router.DeferredFragment)
+	deferFragments = func(sent <-chan struct{}, fragments []*router.DeferredFragment) {
+		for _, f := range fragments {
+			wg.Add(1)
+			go func(f *router.DeferredFragment) {
+				defer wg.Done()
+				done := make(chan struct{})
+				defer close(done)
+				streams := router.NewStreams(ctx)
+				fctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+				result, fragments := f.Execute(fctx, streams)
+				cancel()
+				deferFragments(done, fragments)
+				<-sent
+				if !send(incrementalPayload{Incremental: []router.IncrementalResult{result}, HasNext: true}) {
+					closeStreams(streams)
+					return
+				}
+				stream(streams)
+			}(f)
+		}
+	}
+	// deferred operations are executed concurrently with initial operation,
+	// their results are sent after results of enclosing operations
+	sent := make([]chan struct{}, len(operation.Deferred))
+	for i := range sent {
+		sent[i] = make(chan struct{})
+	}
+	for i, d := range operation.Deferred {
+		wg.Add(1)
+		go func(i int, d router.DeferredOperation) {
+			defer wg.Done()
+			defer close(sent[i])
+			streams := router.NewStreams(ctx)
+			deferred := router.NewDeferred()
+			result := execute(d.Query, streams, deferred)
+			deferFragments(sent[i], deferred.Take(result.Data))
+			if d.Parent >= 0 {
+				<-sent[d.Parent]
+			}
+			if r, ok := d.Result(result); ok && !send(incrementalPayload{Incremental: []router.IncrementalResult{r}, HasNext: true}) {
+				closeStreams(streams)
+				return
+			}
+			stream(streams)
+		}(i, d)
+	}
+	streams := router.NewStreams(ctx)
+	deferred := router.NewDeferred()
+	initial := execute(operation.Query, streams, deferred)
+	if initial.Data == nil && len(initial.Errors) > 0 {
+		cancel()
+		closeStreams(streams)
+		w.write(incrementalPayload{Errors: initial.Errors})
+		wg.Wait()
+		return true
+	}
+	if err := w.write(incrementalPayload{Data: initial.Data, Errors: initial.Errors, HasNext: true}); err != nil {
+		cancel()
+	}
+	stream(streams)
+	// initial result is already written
+	written := make(chan struct{})
+	close(written)
+	deferFragments(written, deferred.Take(initial.Data))
+	go func() {
+		wg.Wait()
+		close(payloads)
+	}()
+	for p := range payloads {
+		if err := w.write(p); err != nil {
+			cancel()
+		}
+	}
+	if ctx.Err() == nil {
+		w.write(incrementalPayload{})
+	}
+	return true
+}
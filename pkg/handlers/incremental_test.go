@@ -0,0 +1,143 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func incrementalHandler(t *testing.T) (http.Handler, *drivertest.MockDriver) {
+	env := router.Environment{
+		Provider: "incremental",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "user"
+	})).Return(driver.FieldResolveOutput{Response: map[string]interface{}{"id": "1", "name": "user"}})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "users"
+	})).Return(driver.FieldResolveOutput{Response: []interface{}{
+		map[string]interface{}{"name": "a"},
+		map[string]interface{}{"name": "b"},
+	}})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, streamDriver{mockDriver})
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.user": {
+				Resolve: types.Function{Name: "user"},
+			},
+			"Query.users": {
+				Resolve: types.Function{Name: "users"},
+			},
+		},
+		Schema: `type User {
+			id: ID
+			name: String
+		}
+		type Query {
+			user: User
+			users: [User]
+		}
+		schema {
+			query: Query
+		}`,
+	})
+	require.NoError(t, err)
+	return handlers.New(handlers.Config{Schema: &rt.Schema}), mockDriver
+}
+
+// streamDriver returns new reader for every stream
+type streamDriver struct {
+	*drivertest.MockDriver
+}
+
+func (s streamDriver) Stream(in driver.StreamInput) driver.StreamOutput {
+	return driver.StreamOutput{
+		Reader: drivertest.NewStreamReader(
+			driver.StreamMessage{Response: map[string]interface{}{"name": "a"}},
+			driver.StreamMessage{Response: map[string]interface{}{"name": "b"}},
+		),
+	}
+}
+
+func readParts(t *testing.T, res *http.Response) []interface{} {
+	mediaType, params, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+	r := multipart.NewReader(res.Body, params["boundary"])
+	var parts []interface{}
+	for {
+		part, err := r.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		require.NoError(t, err)
+		assert.Equal(t, "application/json; charset=utf-8", part.Header.Get("Content-Type"))
+		b, err := ioutil.ReadAll(part)
+		require.NoError(t, err)
+		var v interface{}
+		require.NoError(t, json.Unmarshal(b, &v))
+		parts = append(parts, v)
+	}
+}
+
+func TestHandlerIncremental(t *testing.T) {
+	h, mockDriver := incrementalHandler(t)
+	query := `{"query":"{ user { id ...@defer(label: \"details\") { name } } users @stream(initialCount: 1) { name } }"}`
+	t.Run("DeliversIncrementally", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(query))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "multipart/mixed")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		res := rec.Result()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		parts := readParts(t, res)
+		require.Len(t, parts, 4)
+		var expected interface{}
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"data": {"user": {"id": "1"}, "users": [{"name": "a"}]},
+			"hasNext": true
+		}`), &expected))
+		assert.Equal(t, expected, parts[0])
+		var incremental []interface{}
+		require.NoError(t, json.Unmarshal([]byte(`[
+			{"incremental": [{"data": {"name": "user"}, "path": ["user"], "label": "details"}], "hasNext": true},
+			{"incremental": [{"items": [{"name": "b"}], "path": ["users", 1]}], "hasNext": true}
+		]`), &incremental))
+		assert.ElementsMatch(t, incremental, parts[1:3])
+		assert.Equal(t, map[string]interface{}{"hasNext": false}, parts[3])
+		// user is resolved once, deferred fragment is executed on resolved value
+		mockDriver.AssertNumberOfCalls(t, "FieldResolve", 1)
+	})
+	t.Run("ExecutesWithoutMultipart", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(query))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"data": {"user": {"id": "1", "name": "user"}, "users": [{"name": "a"}, {"name": "b"}]}}`, rec.Body.String())
+	})
+}
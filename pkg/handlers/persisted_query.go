@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+const (
+	defaultPersistedQueryCacheSize = 1000
+	persistedQueryVersion          = 1
+)
+
+// Persisted query error codes returned in error extensions
+const (
+	PersistedQueryNotFound     = "PERSISTED_QUERY_NOT_FOUND"
+	PersistedQueryNotSupported = "PERSISTED_QUERY_NOT_SUPPORTED"
+	PersistedQueryInvalid      = "PERSISTED_QUERY_INVALID"
+)
+
+// PersistedQueryStore keeps queries by their sha256 hash
+type PersistedQueryStore interface {
+	// Get returns query for hash, ok is false if store does not have a query
+	Get(hash string) (query string, ok bool, err error)
+	// Put saves query under hash
+	Put(hash, query string) error
+}
+
+type lruEntry struct {
+	hash  string
+	query string
+}
+
+// LRUPersistedQueryStore is an in-memory store that keeps at most size
+// of recently used queries
+type LRUPersistedQueryStore struct {
+	mu      sync.Mutex
+	size    int
+	entries *list.List
+	index   map[string]*list.Element
+}
+
+// NewLRUPersistedQueryStore creates new in-memory store with max size. If size is
+// not positive, default size of 1000 is used.
+func NewLRUPersistedQueryStore(size int) *LRUPersistedQueryStore {
+	if size <= 0 {
+		size = defaultPersistedQueryCacheSize
+	}
+	return &LRUPersistedQueryStore{
+		size:    size,
+		entries: list.New(),
+		index:   make(map[string]*list.Element, size),
+	}
+}
+
+// Get implements PersistedQueryStore
+func (l *LRUPersistedQueryStore) Get(hash string) (string, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e, ok := l.index[hash]
+	if !ok {
+		return "", false, nil
+	}
+	l.entries.MoveToFront(e)
+	return e.Value.(lruEntry).query, true, nil
+}
+
+// Put implements PersistedQueryStore
+func (l *LRUPersistedQueryStore) Put(hash, query string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e, ok := l.index[hash]; ok {
+		e.Value = lruEntry{hash: hash, query: query}
+		l.entries.MoveToFront(e)
+		return nil
+	}
+	l.index[hash] = l.entries.PushFront(lruEntry{hash: hash, query: query})
+	for l.entries.Len() > l.size {
+		e := l.entries.Back()
+		l.entries.Remove(e)
+		delete(l.index, e.Value.(lruEntry).hash)
+	}
+	return nil
+}
+
+// FilePersistedQueryStore keeps queries as files named <hash>.graphql in a directory
+type FilePersistedQueryStore struct {
+	Dir string
+}
+
+// NewFilePersistedQueryStore creates new file store in dir, creating dir if it does not exist
+func NewFilePersistedQueryStore(dir string) (*FilePersistedQueryStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FilePersistedQueryStore{Dir: dir}, nil
+}
+
+func (f *FilePersistedQueryStore) path(hash string) (string, error) {
+	if !validQueryHash(hash) {
+		return "", errors.New("invalid persisted query hash")
+	}
+	return filepath.Join(f.Dir, hash+".graphql"), nil
+}
+
+// Get implements PersistedQueryStore
+func (f *FilePersistedQueryStore) Get(hash string) (string, bool, error) {
+	p, err := f.path(hash)
+	if err != nil {
+		return "", false, err
+	}
+	b, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return string(b), true, nil
+}
+
+// Put implements PersistedQueryStore
+func (f *FilePersistedQueryStore) Put(hash, query string) error {
+	p, err := f.path(hash)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(f.Dir, hash+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.WriteString(query)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), p)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// QueryHash returns hex encoded sha256 hash of query
+func QueryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func validQueryHash(hash string) bool {
+	if len(hash) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+type requestError struct {
+	message string
+	code    string
+	status  int
+}
+
+func (e requestError) result() *graphql.Result {
+	return &graphql.Result{
+		Errors: []gqlerrors.FormattedError{{
+			Message:    e.message,
+			Extensions: map[string]interface{}{"code": e.code},
+		}},
+	}
+}
+
+// persistedQueryHash returns hash from request extensions, if there's one
+func persistedQueryHash(extensions map[string]interface{}) (string, bool, *requestError) {
+	pq, ok := extensions["persistedQuery"].(map[string]interface{})
+	if !ok {
+		return "", false, nil
+	}
+	if version, _ := pq["version"].(float64); version != persistedQueryVersion {
+		return "", true, &requestError{
+			message: "Unsupported persisted query version",
+			code:    PersistedQueryInvalid,
+			status:  400,
+		}
+	}
+	hash, _ := pq["sha256Hash"].(string)
+	if !validQueryHash(hash) {
+		return "", true, &requestError{
+			message: "Invalid persisted query hash",
+			code:    PersistedQueryInvalid,
+			status:  400,
+		}
+	}
+	return hash, true, nil
+}
+
+// resolvePersistedQuery fills query in options from store, or saves query sent with hash in store
+func (h *Handler) resolvePersistedQuery(opts *requestOptions) *requestError {
+	hash, ok, perr := persistedQueryHash(opts.Extensions)
+	if !ok || perr != nil {
+		return perr
+	}
+	if h.persistedQueries == nil {
+		return &requestError{
+			message: "PersistedQueryNotSupported",
+			code:    PersistedQueryNotSupported,
+			status:  400,
+		}
+	}
+	if opts.Query != "" {
+		if QueryHash(opts.Query) != hash {
+			return &requestError{
+				message: "provided sha does not match query",
+				code:    PersistedQueryInvalid,
+				status:  400,
+			}
+		}
+		if err := h.persistedQueries.Put(hash, opts.Query); err != nil {
+			return &requestError{
+				message: err.Error(),
+				code:    PersistedQueryInvalid,
+				status:  500,
+			}
+		}
+		return nil
+	}
+	query, ok, err := h.persistedQueries.Get(hash)
+	if err != nil {
+		return &requestError{
+			message: err.Error(),
+			code:    PersistedQueryInvalid,
+			status:  500,
+		}
+	}
+	if !ok {
+		return &requestError{
+			message: "PersistedQueryNotFound",
+			code:    PersistedQueryNotFound,
+			status:  200,
+		}
+	}
+	opts.Query = query
+	return nil
+}
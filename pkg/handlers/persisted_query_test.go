@@ -0,0 +1,124 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUPersistedQueryStore(t *testing.T) {
+	store := handlers.NewLRUPersistedQueryStore(2)
+	require.NoError(t, store.Put("a", "{ a }"))
+	require.NoError(t, store.Put("b", "{ b }"))
+	_, ok, _ := store.Get("a")
+	assert.True(t, ok)
+	require.NoError(t, store.Put("c", "{ c }"))
+	_, ok, _ = store.Get("b")
+	assert.False(t, ok)
+	q, ok, err := store.Get("a")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "{ a }", q)
+}
+
+func TestFilePersistedQueryStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stucco-apq")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store, err := handlers.NewFilePersistedQueryStore(dir)
+	require.NoError(t, err)
+	hash := handlers.QueryHash("{ field }")
+	_, ok, err := store.Get(hash)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	require.NoError(t, store.Put(hash, "{ field }"))
+	q, ok, err := store.Get(hash)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "{ field }", q)
+	assert.Error(t, store.Put("../escape", "{ field }"))
+}
+
+func persistedQueryExtensions(hash string) string {
+	return `{"persistedQuery":{"version":1,"sha256Hash":"` + hash + `"}}`
+}
+
+func TestHandlerPersistedQueries(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "value", nil
+					},
+				},
+			},
+		}),
+	})
+	require.NoError(t, err)
+	query := "{ field }"
+	hash := handlers.QueryHash(query)
+	get := func(h http.Handler, values url.Values) (int, map[string]interface{}) {
+		req := httptest.NewRequest(http.MethodGet, "/graphql?"+values.Encode(), nil)
+		req.Header.Set("Accept", "application/json")
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, req)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &body))
+		return rw.Code, body
+	}
+	post := func(h http.Handler, body string) (int, map[string]interface{}) {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, req)
+		var out map[string]interface{}
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &out))
+		return rw.Code, out
+	}
+	errorCode := func(body map[string]interface{}) interface{} {
+		errs, _ := body["errors"].([]interface{})
+		if len(errs) == 0 {
+			return nil
+		}
+		ext, _ := errs[0].(map[string]interface{})["extensions"].(map[string]interface{})
+		return ext["code"]
+	}
+	h := handlers.New(handlers.Config{
+		Schema:           &schema,
+		PersistedQueries: handlers.NewLRUPersistedQueryStore(10),
+	})
+	extensions := url.Values{"extensions": {persistedQueryExtensions(hash)}}
+
+	code, body := get(h, extensions)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, handlers.PersistedQueryNotFound, errorCode(body))
+
+	code, body = post(h, `{"query":"{ field }","extensions":`+persistedQueryExtensions(hash)+`}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]interface{}{"field": "value"}, body["data"])
+
+	code, body = get(h, extensions)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]interface{}{"field": "value"}, body["data"])
+
+	code, body = post(h, `{"query":"{ other }","extensions":`+persistedQueryExtensions(hash)+`}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, handlers.PersistedQueryInvalid, errorCode(body))
+
+	code, body = get(handlers.New(handlers.Config{Schema: &schema}), extensions)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, handlers.PersistedQueryNotSupported, errorCode(body))
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/graphql-editor/stucco/pkg/pubsub"
+)
+
+type publishRequest struct {
+	Topic   string      `json:"topic"`
+	Payload interface{} `json:"payload"`
+}
+
+type publishResponse struct {
+	Subscribers int `json:"subscribers"`
+}
+
+// PublishHandler publishes payloads to topics of pub/sub broker. Requests must be
+// authorized with a bearer token.
+type PublishHandler struct {
+	Broker *pubsub.Broker
+	// Token authorizing requests, all requests are rejected if empty
+	Token string
+}
+
+// NewPublishHandler returns new handler publishing to broker
+func NewPublishHandler(broker *pubsub.Broker, token string) *PublishHandler {
+	return &PublishHandler{
+		Broker: broker,
+		Token:  token,
+	}
+}
+
+func (p *PublishHandler) authorized(req *http.Request) bool {
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	return p.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(p.Token)) == 1
+}
+
+// ServeHTTP implements http.Handler
+func (p *PublishHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if !p.authorized(req) {
+		http.Error(rw, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	var pr publishRequest
+	if err := json.NewDecoder(req.Body).Decode(&pr); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if pr.Topic == "" {
+		http.Error(rw, "topic is required", http.StatusBadRequest)
+		return
+	}
+	b, _ := json.Marshal(publishResponse{Subscribers: p.Broker.Publish(pr.Topic, pr.Payload)})
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	rw.Write(b)
+}
@@ -0,0 +1,74 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-editor/stucco/pkg/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishHandler(t *testing.T) {
+	broker := pubsub.New()
+	sub := broker.Subscribe("room.a")
+	defer sub.Close()
+	received := make(chan interface{}, 1)
+	go func() {
+		if sub.Next() {
+			v, _ := sub.Read()
+			received <- v
+		}
+	}()
+	h := handlers.NewPublishHandler(broker, "secret")
+	data := []struct {
+		title        string
+		token        string
+		body         string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			title:        "RejectsMissingToken",
+			body:         `{"topic":"room.a","payload":"hello"}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			title:        "RejectsInvalidToken",
+			token:        "invalid",
+			body:         `{"topic":"room.a","payload":"hello"}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			title:        "RejectsMissingTopic",
+			token:        "secret",
+			body:         `{"payload":"hello"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			title:        "Publishes",
+			token:        "secret",
+			body:         `{"topic":"room.a","payload":"hello"}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"subscribers":1}`,
+		},
+	}
+	for i := range data {
+		tt := data[i]
+		t.Run(tt.title, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/publish", strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(t, tt.expectedCode, rec.Code)
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+	assert.Equal(t, "hello", <-received)
+}
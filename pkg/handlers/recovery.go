@@ -1,16 +1,24 @@
 package handlers
 
 import (
+	"encoding/json"
 	"net/http"
 	"runtime/debug"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
 )
 
+// InternalServerError is an error code returned in error extensions when request panicked
+const InternalServerError = "INTERNAL_SERVER_ERROR"
+
 // ErrorLog used by recovery handler
 type ErrorLog interface {
 	Errorf(string, ...interface{})
 }
 
 // RecoveryHandler recovers from panics to return Internal Server Error http response
+// with GraphQL error in body
 func RecoveryHandler(next http.Handler, logger ErrorLog) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		defer func() {
@@ -20,11 +28,15 @@ func RecoveryHandler(next http.Handler, logger ErrorLog) http.Handler {
 					logger.Errorf("%v\n", err)
 					logger.Errorf("%s\n", debug.Stack())
 				}
-				rw.Header().Set("Content-Type", "text/plain")
+				b, _ := json.Marshal(&graphql.Result{
+					Errors: []gqlerrors.FormattedError{{
+						Message:    "There was an internal server error",
+						Extensions: map[string]interface{}{"code": InternalServerError},
+					}},
+				})
+				rw.Header().Set("Content-Type", "application/json; charset=utf-8")
 				rw.WriteHeader(http.StatusInternalServerError)
-				if _, err := rw.Write(
-					[]byte("There was an internal server error"),
-				); err != nil && logger != nil {
+				if _, err := rw.Write(b); err != nil && logger != nil {
 					logger.Errorf("%v\n", err)
 				}
 			}
@@ -0,0 +1,32 @@
+package handlers
+
+// OperationNotAllowed is an error code returned when operation is not in safelist
+const OperationNotAllowed = "OPERATION_NOT_ALLOWED"
+
+// checkSafelist fills query from safelist if request has an operation id and
+// rejects operations that are not in safelist in strict mode
+func (h *Handler) checkSafelist(opts *requestOptions) *requestError {
+	if h.safelist == nil {
+		return nil
+	}
+	if opts.ID != "" && opts.Query == "" {
+		query, ok := h.safelist.Operation(opts.ID)
+		if !ok {
+			return &requestError{
+				message: "unknown operation id " + opts.ID,
+				code:    OperationNotAllowed,
+				status:  400,
+			}
+		}
+		opts.Query = query
+		return nil
+	}
+	if h.safelist.Strict && !h.safelist.Allowed(opts.Query) {
+		return &requestError{
+			message: "operation is not allowed",
+			code:    OperationNotAllowed,
+			status:  400,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-editor/stucco/pkg/safelist"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerSafelist(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "value", nil
+					},
+				},
+			},
+		}),
+	})
+	require.NoError(t, err)
+	op, err := safelist.NewOperation("getField", "query GetField { field }")
+	require.NoError(t, err)
+	manifest := &safelist.Manifest{Operations: []safelist.Operation{op}}
+	data := []struct {
+		title        string
+		strict       bool
+		values       url.Values
+		expectedCode int
+		expectedData interface{}
+	}{
+		{
+			title:        "AllowsOperationInManifest",
+			strict:       true,
+			values:       url.Values{"query": {"query GetField {\n  field\n}"}},
+			expectedCode: http.StatusOK,
+			expectedData: map[string]interface{}{"field": "value"},
+		},
+		{
+			title:        "AllowsOperationByID",
+			strict:       true,
+			values:       url.Values{"id": {"getField"}},
+			expectedCode: http.StatusOK,
+			expectedData: map[string]interface{}{"field": "value"},
+		},
+		{
+			title:        "RejectsUnknownID",
+			strict:       false,
+			values:       url.Values{"id": {"unknown"}},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			title:        "RejectsOperationNotInManifest",
+			strict:       true,
+			values:       url.Values{"query": {"{ field }"}},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			title:        "AllowsOperationNotInManifestInNonStrictMode",
+			strict:       false,
+			values:       url.Values{"query": {"{ field }"}},
+			expectedCode: http.StatusOK,
+			expectedData: map[string]interface{}{"field": "value"},
+		},
+	}
+	for i := range data {
+		tt := data[i]
+		t.Run(tt.title, func(t *testing.T) {
+			h := handlers.New(handlers.Config{
+				Schema:   &schema,
+				Safelist: safelist.New(manifest, tt.strict),
+			})
+			req := httptest.NewRequest(http.MethodGet, "/graphql?"+tt.values.Encode(), nil)
+			req.Header.Set("Accept", "application/json")
+			rw := httptest.NewRecorder()
+			h.ServeHTTP(rw, req)
+			assert.Equal(t, tt.expectedCode, rw.Code)
+			var body map[string]interface{}
+			require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &body))
+			if tt.expectedData != nil {
+				assert.Equal(t, tt.expectedData, body["data"])
+			} else {
+				errs, _ := body["errors"].([]interface{})
+				require.Len(t, errs, 1)
+				ext := errs[0].(map[string]interface{})["extensions"].(map[string]interface{})
+				assert.Equal(t, handlers.OperationNotAllowed, ext["code"])
+			}
+		})
+	}
+}
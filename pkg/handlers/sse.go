@@ -0,0 +1,413 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"k8s.io/klog"
+)
+
+// EventStreamTokenHeader is a header with token of reserved event stream in single connection mode
+const EventStreamTokenHeader = "X-GraphQL-Event-Stream-Token"
+
+const (
+	// DefaultMaxEventStreams is a default maximum number of event streams reserved in single connection mode
+	DefaultMaxEventStreams = 1024
+	// DefaultEventStreamReservationTimeout is a default time in which client must connect to reserved event stream
+	DefaultEventStreamReservationTimeout = 30 * time.Second
+)
+
+// SSEKeepAliveInterval is an interval in which keepalive comments are written to event stream
+var SSEKeepAliveInterval = 12 * time.Second
+
+// sseWriter writes events to text/event-stream response
+type sseWriter struct {
+	lock    sync.Mutex
+	rw      http.ResponseWriter
+	flusher http.Flusher
+}
+
+func newSSEWriter(rw http.ResponseWriter) (*sseWriter, bool) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return &sseWriter{rw: rw, flusher: flusher}, true
+}
+
+func (w *sseWriter) write(s string) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if _, err := w.rw.Write([]byte(s)); err != nil {
+		return err
+	}
+	w.flusher.Flush()
+	return nil
+}
+
+// event writes event with JSON encoded data, nil data is written as empty
+func (w *sseWriter) event(event string, data interface{}) error {
+	var b []byte
+	if data != nil {
+		var err error
+		if b, err = json.Marshal(data); err != nil {
+			return err
+		}
+	}
+	return w.write("event: " + event + "\ndata: " + string(b) + "\n\n")
+}
+
+// keepAlive writes keepalive comments until context is done
+func (w *sseWriter) keepAlive(ctx context.Context) {
+	t := time.NewTicker(SSEKeepAliveInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := w.write(":\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+type sseEvent struct {
+	event string
+	data  interface{}
+}
+
+// sseStream is an event stream reserved in single connection mode
+type sseStream struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	events     chan sseEvent
+	lock       sync.Mutex
+	connected  bool
+	operations map[string]context.CancelFunc
+	expiry     *time.Timer
+}
+
+func (s *sseStream) send(ctx context.Context, event string, data interface{}) error {
+	select {
+	case s.events <- sseEvent{event: event, data: data}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// start adds operation, returns false if operation with id already exists
+func (s *sseStream) start(id string) (context.Context, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.operations[id]; ok {
+		return nil, false
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.operations[id] = cancel
+	return ctx, true
+}
+
+// stop cancels operation
+func (s *sseStream) stop(id string) {
+	s.lock.Lock()
+	cancel, ok := s.operations[id]
+	delete(s.operations, id)
+	s.lock.Unlock()
+	if ok {
+		cancel()
+	}
+}
+
+// valuesContext is a context with values of another context
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c valuesContext) Value(key interface{}) interface{} {
+	return c.values.Value(key)
+}
+
+func acceptsEventStream(req *http.Request) bool {
+	return strings.Contains(req.Header.Get("Accept"), "text/event-stream")
+}
+
+// isStreamReservation returns true if request reserves event stream, that is a PUT
+// request without a body accepting token in plain text
+func isStreamReservation(req *http.Request) bool {
+	if req.Method != http.MethodPut || req.ContentLength != 0 {
+		return false
+	}
+	accept := req.Header.Get("Accept")
+	return accept == "" ||
+		strings.Contains(accept, "text/plain") ||
+		strings.Contains(accept, "text/*") ||
+		strings.Contains(accept, "*/*")
+}
+
+func eventStreamToken(req *http.Request) string {
+	if token := req.Header.Get(EventStreamTokenHeader); token != "" {
+		return token
+	}
+	return req.URL.Query().Get("token")
+}
+
+// serveSSE handles GraphQL over Server-Sent Events requests, returns false
+// if request does not use event streams
+func (h *Handler) serveSSE(rw http.ResponseWriter, req *http.Request) bool {
+	token := eventStreamToken(req)
+	switch {
+	case isStreamReservation(req):
+		h.reserveEventStream(rw)
+	case token != "" && req.Method == http.MethodGet && acceptsEventStream(req):
+		h.serveEventStream(rw, req, token)
+	case token != "" && req.Method == http.MethodPost:
+		h.startStreamOperation(rw, req, token)
+	case token != "" && req.Method == http.MethodDelete:
+		h.stopStreamOperation(rw, req, token)
+	case token == "" && acceptsEventStream(req):
+		h.serveDistinctEventStream(rw, req)
+	default:
+		return false
+	}
+	return true
+}
+
+// serveDistinctEventStream executes operation from request and streams its results
+// in response
+func (h *Handler) serveDistinctEventStream(rw http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	opts := newRequestOptions(req)
+	if perr := h.checkRequest(opts); perr != nil {
+		h.writeResult(rw, perr.status, perr.result())
+		return
+	}
+	w, ok := newSSEWriter(rw)
+	if !ok {
+		http.Error(rw, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go w.keepAlive(ctx)
+	var rootObject map[string]interface{}
+	if h.rootObjectFn != nil {
+		rootObject = h.rootObjectFn(ctx, req)
+	}
+	result, sub := h.execute(ctx, opts, rootObject)
+	if sub == nil {
+		if err := w.event("next", result); err != nil {
+			return
+		}
+	} else {
+		err := sub.stream(ctx, func(result *graphql.Result) error {
+			return w.event("next", result)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.event("next", &graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+		}
+	}
+	w.event("complete", nil)
+}
+
+// reserveEventStream reserves event stream for operations in single connection mode.
+// Stream that client does not connect to in time expires with its pending operations.
+func (h *Handler) reserveEventStream(rw http.ResponseWriter) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	token := hex.EncodeToString(b)
+	maxStreams := h.maxEventStreams
+	if maxStreams <= 0 {
+		maxStreams = DefaultMaxEventStreams
+	}
+	timeout := h.eventStreamReservationTimeout
+	if timeout <= 0 {
+		timeout = DefaultEventStreamReservationTimeout
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &sseStream{
+		ctx:        ctx,
+		cancel:     cancel,
+		events:     make(chan sseEvent, 16),
+		operations: make(map[string]context.CancelFunc),
+	}
+	h.streamsLock.Lock()
+	if len(h.streams) >= maxStreams {
+		h.streamsLock.Unlock()
+		cancel()
+		http.Error(rw, "too many event streams", http.StatusTooManyRequests)
+		return
+	}
+	if h.streams == nil {
+		h.streams = make(map[string]*sseStream)
+	}
+	h.streams[token] = s
+	s.expiry = time.AfterFunc(timeout, func() {
+		s.lock.Lock()
+		connected := s.connected
+		if !connected {
+			s.cancel()
+		}
+		s.lock.Unlock()
+		if !connected {
+			h.removeEventStream(token, s)
+		}
+	})
+	h.streamsLock.Unlock()
+	rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	rw.WriteHeader(http.StatusCreated)
+	rw.Write([]byte(token))
+}
+
+// removeEventStream removes reserved stream and cancels its operations
+func (h *Handler) removeEventStream(token string, s *sseStream) {
+	h.streamsLock.Lock()
+	if h.streams[token] == s {
+		delete(h.streams, token)
+	}
+	h.streamsLock.Unlock()
+	s.cancel()
+}
+
+func (h *Handler) eventStream(token string) (*sseStream, bool) {
+	h.streamsLock.Lock()
+	defer h.streamsLock.Unlock()
+	s, ok := h.streams[token]
+	return s, ok
+}
+
+// serveEventStream writes events of operations of reserved stream until client disconnects
+func (h *Handler) serveEventStream(rw http.ResponseWriter, req *http.Request, token string) {
+	s, ok := h.eventStream(token)
+	if !ok {
+		http.Error(rw, "stream not found", http.StatusNotFound)
+		return
+	}
+	s.lock.Lock()
+	expired := s.ctx.Err() != nil
+	connected := s.connected
+	s.connected = true
+	s.lock.Unlock()
+	if expired {
+		http.Error(rw, "stream not found", http.StatusNotFound)
+		return
+	}
+	if connected {
+		http.Error(rw, "stream already open", http.StatusConflict)
+		return
+	}
+	s.expiry.Stop()
+	defer h.removeEventStream(token, s)
+	w, ok := newSSEWriter(rw)
+	if !ok {
+		http.Error(rw, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	go w.keepAlive(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-s.events:
+			if err := w.event(e.event, e.data); err != nil {
+				klog.Error(err.Error())
+				return
+			}
+		}
+	}
+}
+
+// startStreamOperation executes operation with results written to reserved stream
+func (h *Handler) startStreamOperation(rw http.ResponseWriter, req *http.Request, token string) {
+	s, ok := h.eventStream(token)
+	if !ok {
+		http.Error(rw, "stream not found", http.StatusNotFound)
+		return
+	}
+	opts := newRequestOptions(req)
+	id, _ := opts.Extensions["operationId"].(string)
+	if id == "" {
+		http.Error(rw, "operation id is missing", http.StatusBadRequest)
+		return
+	}
+	if perr := h.checkRequest(opts); perr != nil {
+		h.writeResult(rw, perr.status, perr.result())
+		return
+	}
+	ctx, ok := s.start(id)
+	if !ok {
+		http.Error(rw, fmt.Sprintf("operation with id %s already exists", id), http.StatusConflict)
+		return
+	}
+	// operation outlives the request that started it
+	ctx = valuesContext{Context: ctx, values: req.Context()}
+	var rootObject map[string]interface{}
+	if h.rootObjectFn != nil {
+		rootObject = h.rootObjectFn(ctx, req)
+	}
+	go h.streamOperation(ctx, s, id, opts, rootObject)
+	rw.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) streamOperation(ctx context.Context, s *sseStream, id string, opts *requestOptions, rootObject map[string]interface{}) {
+	defer s.stop(id)
+	next := func(result *graphql.Result) error {
+		return s.send(ctx, "next", map[string]interface{}{"id": id, "payload": result})
+	}
+	result, sub := h.execute(ctx, opts, rootObject)
+	if sub == nil {
+		if err := next(result); err != nil {
+			return
+		}
+	} else {
+		err := sub.stream(ctx, next)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			next(&graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+		}
+	}
+	s.send(ctx, "complete", map[string]interface{}{"id": id})
+}
+
+// stopStreamOperation stops operation of reserved stream
+func (h *Handler) stopStreamOperation(rw http.ResponseWriter, req *http.Request, token string) {
+	s, ok := h.eventStream(token)
+	if !ok {
+		http.Error(rw, "stream not found", http.StatusNotFound)
+		return
+	}
+	id := req.URL.Query().Get("operationId")
+	if id == "" {
+		http.Error(rw, "operation id is missing", http.StatusBadRequest)
+		return
+	}
+	s.stop(id)
+	rw.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,154 @@
+package handlers_test
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func anyListen(driver.SubscriptionListenInput) bool {
+	return true
+}
+
+func TestHandlerSSEDistinctConnection(t *testing.T) {
+	srv := subscriptionServer(t, anyListen)
+	defer srv.Close()
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"?"+url.Values{
+		"query": {"subscription { counter }"},
+	}.Encode(), nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	b, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "event: next\ndata: {\"data\":{\"counter\":1}}\n\n"+
+		"event: next\ndata: {\"data\":{\"counter\":2}}\n\n"+
+		"event: complete\ndata: \n\n", string(b))
+}
+
+func TestHandlerSSESingleConnection(t *testing.T) {
+	srv := subscriptionServer(t, anyListen)
+	defer srv.Close()
+	req, err := http.NewRequest(http.MethodPut, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	token, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+
+	req, err = http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set(handlers.EventStreamTokenHeader, string(token))
+	stream, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer stream.Body.Close()
+	assert.Equal(t, http.StatusOK, stream.StatusCode)
+
+	r := bufio.NewReader(stream.Body)
+	for _, op := range []struct{ id, query string }{
+		{"1", "subscription { counter }"},
+		{"2", "{ field }"},
+	} {
+		req, err = http.NewRequest(
+			http.MethodPost,
+			srv.URL,
+			strings.NewReader(`{"query":"`+op.query+`","extensions":{"operationId":"`+op.id+`"}}`),
+		)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(handlers.EventStreamTokenHeader, string(token))
+		resp, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+		var expected []string
+		if op.id == "1" {
+			expected = []string{
+				"event: next", `data: {"id":"1","payload":{"data":{"counter":1}}}`, "",
+				"event: next", `data: {"id":"1","payload":{"data":{"counter":2}}}`, "",
+				"event: complete", `data: {"id":"1"}`, "",
+			}
+		} else {
+			expected = []string{
+				"event: next", `data: {"id":"2","payload":{"data":{"field":"value"}}}`, "",
+				"event: complete", `data: {"id":"2"}`, "",
+			}
+		}
+		var lines []string
+		for len(lines) < len(expected) {
+			line, err := r.ReadString('\n')
+			require.NoError(t, err)
+			lines = append(lines, strings.TrimSuffix(line, "\n"))
+		}
+		assert.Equal(t, expected, lines)
+	}
+}
+
+func TestHandlerSSEReservation(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: graphql.Fields{"field": &graphql.Field{Type: graphql.String}},
+		}),
+	})
+	require.NoError(t, err)
+	srv := httptest.NewServer(handlers.New(handlers.Config{
+		Schema:                        &schema,
+		MaxEventStreams:               1,
+		EventStreamReservationTimeout: 50 * time.Millisecond,
+	}))
+	defer srv.Close()
+	reserve := func(body io.Reader, accept string) (int, string) {
+		req, err := http.NewRequest(http.MethodPut, srv.URL, body)
+		require.NoError(t, err)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(b)
+	}
+
+	status, _ := reserve(strings.NewReader(`{"query":"{ field }"}`), "")
+	assert.NotEqual(t, http.StatusCreated, status)
+	status, _ = reserve(nil, "application/json")
+	assert.NotEqual(t, http.StatusCreated, status)
+
+	status, token := reserve(nil, "")
+	assert.Equal(t, http.StatusCreated, status)
+	status, _ = reserve(nil, "text/plain")
+	assert.Equal(t, http.StatusTooManyRequests, status)
+
+	time.Sleep(100 * time.Millisecond)
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set(handlers.EventStreamTokenHeader, token)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	status, _ = reserve(nil, "")
+	assert.Equal(t, http.StatusCreated, status)
+}
@@ -8,12 +8,14 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"k8s.io/klog"
 
 	"github.com/gorilla/websocket"
 	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/safelist"
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/handler"
 )
@@ -29,6 +31,30 @@ type Config struct {
 	GraphiQL     bool
 	RootObjectFn handler.RootObjectFn
 	CheckOrigin  func(req *http.Request) bool
+	// PersistedQueries enables automatic persisted queries if not nil
+	PersistedQueries PersistedQueryStore
+	// Safelist limits operations that can be executed if not nil
+	Safelist *safelist.Safelist
+	// MaxUploadSize limits size of multipart requests, defaults to DefaultMaxUploadSize,
+	// negative value disables the limit
+	MaxUploadSize int64
+	// MaxUploadFileSize limits size of a single uploaded file, zero disables the limit
+	MaxUploadFileSize int64
+	// UploadMemory is a number of bytes of uploaded files kept in memory before
+	// they are spooled to temporary files, defaults to DefaultUploadMemory
+	UploadMemory int64
+	// MaxBatchSize limits number of operations in batched request, defaults to
+	// DefaultMaxBatchSize, negative value disables batching
+	MaxBatchSize int
+	// BatchParallelism is a number of operations of batch executed concurrently,
+	// defaults to DefaultBatchParallelism
+	BatchParallelism int
+	// MaxEventStreams limits number of event streams reserved in single connection mode,
+	// defaults to DefaultMaxEventStreams
+	MaxEventStreams int
+	// EventStreamReservationTimeout is a time in which client must connect to reserved
+	// event stream before it expires, defaults to DefaultEventStreamReservationTimeout
+	EventStreamReservationTimeout time.Duration
 }
 
 // subscriptionHandler is a websocket handler
@@ -78,6 +104,33 @@ func (s subscriptionHandler) writeResult(ws *websocket.Conn, result *graphql.Res
 	return err
 }
 
+// stream calls next with results of subscription for each value emitted by reader until
+// reader is finished or context is done. Reader is closed when context is done.
+func (s subscriptionHandler) stream(ctx context.Context, next func(*graphql.Result) error) error {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		s.sub.Reader.Close()
+	}()
+	for s.sub.Reader.Next() {
+		v, err := s.sub.Reader.Read()
+		if err != nil {
+			return err
+		}
+		if err := next(s.do(v)); err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return s.sub.Reader.Error()
+}
+
 // Handle subscription websocket
 func (s subscriptionHandler) Handle(ws *websocket.Conn) {
 	defer ws.Close()
@@ -113,6 +166,21 @@ type Handler struct {
 	upgrader       websocket.Upgrader
 	rootObjectFn   handler.RootObjectFn
 	requestTimeout time.Duration
+
+	persistedQueries PersistedQueryStore
+	safelist         *safelist.Safelist
+
+	maxUploadSize     int64
+	maxUploadFileSize int64
+	uploadMemory      int64
+
+	maxBatchSize     int
+	batchParallelism int
+
+	maxEventStreams               int
+	eventStreamReservationTimeout time.Duration
+	streamsLock                   sync.Mutex
+	streams                       map[string]*sseStream
 }
 
 type requestOptions struct {
@@ -121,6 +189,8 @@ type requestOptions struct {
 	OperationName       string                 `json:"operationName" url:"operationName" schema:"operationName"`
 	RawSubscription     bool                   `json:"rawSubscription" url:"rawSubscription" schema:"rawSubscription"`
 	SubscriptionPayload string                 `json:"subscriptionPayload" url:"subscriptionPayload" schema:"subscriptionPayload"`
+	Extensions          map[string]interface{} `json:"extensions" url:"extensions" schema:"extensions"`
+	ID                  string                 `json:"id" url:"id" schema:"id"`
 }
 
 // a workaround for getting`variables` as a JSON string
@@ -130,6 +200,8 @@ type requestOptionsCompatibility struct {
 	OperationName       string `json:"operationName" url:"operationName" schema:"operationName"`
 	RawSubscription     bool   `json:"rawSubscription" url:"rawSubscription" schema:"rawSubscription"`
 	SubscriptionPayload string `json:"subscriptionPayload" url:"subscriptionPayload" schema:"subscriptionPayload"`
+	Extensions          string `json:"extensions" url:"extensions" schema:"extensions"`
+	ID                  string `json:"id" url:"id" schema:"id"`
 }
 
 func valueBool(v url.Values, k string) bool {
@@ -146,7 +218,13 @@ func valueBool(v url.Values, k string) bool {
 
 func getFromForm(values url.Values) *requestOptions {
 	query := values.Get("query")
-	if query != "" {
+	// get extensions map, query can be omitted with persisted query
+	var extensions map[string]interface{}
+	if extensionsStr := values.Get("extensions"); extensionsStr != "" {
+		json.Unmarshal([]byte(extensionsStr), &extensions)
+	}
+	id := values.Get("id")
+	if query != "" || extensions != nil || id != "" {
 		// get variables map
 		variables := make(map[string]interface{}, len(values))
 		variablesStr := values.Get("variables")
@@ -157,6 +235,8 @@ func getFromForm(values url.Values) *requestOptions {
 			OperationName:       values.Get("operationName"),
 			RawSubscription:     valueBool(values, "raw"),
 			SubscriptionPayload: values.Get("subscriptionPayload"),
+			Extensions:          extensions,
+			ID:                  id,
 		}
 	}
 
@@ -216,6 +296,10 @@ func newRequestOptions(r *http.Request) *requestOptions {
 			var optsCompatible requestOptionsCompatibility
 			json.Unmarshal(body, &optsCompatible)
 			json.Unmarshal([]byte(optsCompatible.Variables), &opts.Variables)
+			if opts.Extensions == nil {
+				json.Unmarshal([]byte(optsCompatible.Extensions), &opts.Extensions)
+			}
+			opts.ID = optsCompatible.ID
 		}
 		return &opts
 	}
@@ -224,8 +308,45 @@ func newRequestOptions(r *http.Request) *requestOptions {
 // ServeHTTP implements http.Handler
 func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
+	if websocket.IsWebSocketUpgrade(req) {
+		if protocol, ok := wsSubprotocol(req); ok {
+			h.serveWS(rw, req, protocol)
+			return
+		}
+	}
+	if h.serveSSE(rw, req) {
+		return
+	}
+	if batch, ok, err := batchRequestOptions(req); ok || err != nil {
+		if err != nil {
+			perr := requestError{message: err.Error(), code: InvalidBatch, status: http.StatusBadRequest}
+			h.writeResult(rw, perr.status, perr.result())
+			return
+		}
+		h.serveBatch(rw, req, batch)
+		return
+	}
 	// get query
-	opts := newRequestOptions(req)
+	var opts *requestOptions
+	if isMultipart(req) {
+		ops, batched, perr := h.multipartRequestOptions(rw, req)
+		if perr != nil {
+			h.writeResult(rw, perr.status, perr.result())
+			return
+		}
+		defer req.MultipartForm.RemoveAll()
+		if batched {
+			h.serveBatch(rw, req, ops)
+			return
+		}
+		opts = ops[0]
+	} else {
+		opts = newRequestOptions(req)
+	}
+	if perr := h.checkRequest(opts); perr != nil {
+		h.writeResult(rw, perr.status, perr.result())
+		return
+	}
 	if opts.RawSubscription {
 		ctx = context.WithValue(ctx, router.RawSubscriptionKey, true)
 	}
@@ -256,6 +377,9 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 			return
 		}
 	}
+	if acceptsMultipartMixed(req) && h.serveIncremental(ctx, rw, opts, params.RootObject) {
+		return
+	}
 	pctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
 	params.Context = pctx
 	result := graphql.Do(params)
@@ -282,14 +406,61 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		subHandler.Handle(conn)
 		return
 	}
+	if sub, ok := result.Extensions["subscriptionRedirect"].(router.RedirectSubscriptionPayload); ok && len(result.Errors) == 0 {
+		http.Redirect(rw, req, sub.URL, http.StatusFound)
+		return
+	}
+
+	h.writeResult(rw, http.StatusOK, result)
+}
 
+// checkRequest resolves persisted query and checks operation against safelist
+func (h *Handler) checkRequest(opts *requestOptions) *requestError {
+	if perr := h.resolvePersistedQuery(opts); perr != nil {
+		return perr
+	}
+	return h.checkSafelist(opts)
+}
+
+// execute executes operation, if operation is a blocking subscription, returned
+// subscription handler streams its results
+func (h *Handler) execute(ctx context.Context, opts *requestOptions, rootObject map[string]interface{}) (*graphql.Result, *subscriptionHandler) {
+	pctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	result := graphql.Do(graphql.Params{
+		Schema:         *h.Schema,
+		RequestString:  opts.Query,
+		VariableValues: opts.Variables,
+		OperationName:  opts.OperationName,
+		Context:        pctx,
+		RootObject:     rootObject,
+	})
+	cancel()
+	sub, ok := result.Extensions["subscriptionBlocking"].(router.BlockingSubscriptionPayload)
+	if !ok || len(result.Errors) > 0 {
+		return result, nil
+	}
+	return result, &subscriptionHandler{
+		pretty:         h.pretty,
+		schema:         h.Schema,
+		sub:            sub,
+		ctx:            ctx,
+		rootObject:     rootObject,
+		requestTimeout: h.requestTimeout,
+	}
+}
+
+func (h *Handler) writeResult(rw http.ResponseWriter, status int, result *graphql.Result) {
+	h.writeJSON(rw, status, result)
+}
+
+func (h *Handler) writeJSON(rw http.ResponseWriter, status int, v interface{}) {
 	rw.Header().Add("Content-Type", "application/json; charset=utf-8")
 	var buff []byte
-	rw.WriteHeader(http.StatusOK)
+	rw.WriteHeader(status)
 	if h.pretty {
-		buff, _ = json.MarshalIndent(result, "", "\t")
+		buff, _ = json.MarshalIndent(v, "", "\t")
 	} else {
-		buff, _ = json.Marshal(result)
+		buff, _ = json.Marshal(v)
 	}
 	rw.Write(buff)
 }
@@ -319,7 +490,19 @@ func New(cfg Config) *Handler {
 			WriteBufferSize:   1024,
 			EnableCompression: true,
 		},
-		rootObjectFn: cfg.RootObjectFn,
+		rootObjectFn:     cfg.RootObjectFn,
+		persistedQueries: cfg.PersistedQueries,
+		safelist:         cfg.Safelist,
+
+		maxUploadSize:     cfg.MaxUploadSize,
+		maxUploadFileSize: cfg.MaxUploadFileSize,
+		uploadMemory:      cfg.UploadMemory,
+
+		maxBatchSize:     cfg.MaxBatchSize,
+		batchParallelism: cfg.BatchParallelism,
+
+		maxEventStreams:               cfg.MaxEventStreams,
+		eventStreamReservationTimeout: cfg.EventStreamReservationTimeout,
 	}
 	switch requestTimeout := cfg.RouterConfig.RequestTimeout; {
 	case requestTimeout == 0:
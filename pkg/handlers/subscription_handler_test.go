@@ -0,0 +1,69 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerRedirectSubscription(t *testing.T) {
+	env := router.Environment{
+		Provider: "redirect",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("SubscriptionConnection", mock.MatchedBy(func(in driver.SubscriptionConnectionInput) bool {
+		return in.Function.Name == "redirect"
+	})).Return(driver.SubscriptionConnectionOutput{Response: "wss://subscriptions.example.com/graphql?token=abc"})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Subscriptions: router.SubscriptionConfig{
+			Kind:     router.RedirectSubscription,
+			Redirect: types.Function{Name: "redirect"},
+		},
+		Schema: `type Query {
+			field: String
+		}
+		type Subscription {
+			counter(step: Int): Int
+		}
+		schema {
+			query: Query
+			subscription: Subscription
+		}`,
+	})
+	require.NoError(t, err)
+	h := handlers.New(handlers.Config{Schema: &rt.Schema})
+	query := "subscription Counter($step: Int) { counter(step: $step) }"
+	req := httptest.NewRequest(http.MethodGet, "/graphql?"+url.Values{
+		"query":     {query},
+		"variables": {`{"step":2}`},
+	}.Encode(), nil)
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusFound, rec.Code)
+	location, err := url.Parse(rec.Header().Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, "subscriptions.example.com", location.Host)
+	assert.Equal(t, url.Values{
+		"token":     {"abc"},
+		"query":     {query},
+		"variables": {`{"step":2}`},
+	}, location.Query())
+}
@@ -0,0 +1,358 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"k8s.io/klog"
+)
+
+const (
+	// GraphQLTransportWSProtocol is a websocket subprotocol used by graphql-ws library
+	GraphQLTransportWSProtocol = "graphql-transport-ws"
+	// GraphQLWSProtocol is a websocket subprotocol used by legacy subscriptions-transport-ws library
+	GraphQLWSProtocol = "graphql-ws"
+)
+
+// ConnectionInitWaitTimeout is a time in which client must send connection_init message
+var ConnectionInitWaitTimeout = 10 * time.Second
+
+// close codes of graphql-transport-ws protocol
+const (
+	closeInvalidMessage      = 4400
+	closeUnauthorized        = 4401
+	closeInitTimeout         = 4408
+	closeSubscriberExists    = 4409
+	closeTooManyInitRequests = 4429
+	closeInternalServerError = 4500
+)
+
+// message types shared by subprotocols
+const (
+	connectionInitMessageType = "connection_init"
+	connectionAckMessageType  = "connection_ack"
+	completeMessageType       = "complete"
+	errorMessageType          = "error"
+)
+
+// wsProtocol describes message types of a websocket subprotocol
+type wsProtocol struct {
+	name      string
+	subscribe string
+	next      string
+	stop      string
+	// errorPayload returns payload of error message
+	errorPayload func([]gqlerrors.FormattedError) interface{}
+}
+
+var wsProtocols = []wsProtocol{
+	{
+		name:      GraphQLTransportWSProtocol,
+		subscribe: "subscribe",
+		next:      "next",
+		stop:      "complete",
+		errorPayload: func(errs []gqlerrors.FormattedError) interface{} {
+			return errs
+		},
+	},
+	{
+		name:      GraphQLWSProtocol,
+		subscribe: "start",
+		next:      "data",
+		stop:      "stop",
+		errorPayload: func(errs []gqlerrors.FormattedError) interface{} {
+			return errs[0]
+		},
+	},
+}
+
+// wsSubprotocol returns first of supported subprotocols requested by client,
+// requests without one are handled by legacy subscription handler
+func wsSubprotocol(req *http.Request) (wsProtocol, bool) {
+	for _, name := range websocket.Subprotocols(req) {
+		for _, p := range wsProtocols {
+			if p.name == name {
+				return p, true
+			}
+		}
+	}
+	return wsProtocol{}, false
+}
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// wsConnection multiplexes operations of a client over one websocket connection
+type wsConnection struct {
+	h          *Handler
+	protocol   wsProtocol
+	conn       *websocket.Conn
+	ctx        context.Context
+	rootObject map[string]interface{}
+
+	writeLock    sync.Mutex
+	lock         sync.Mutex
+	initialized  bool
+	acknowledged bool
+	operations   map[string]func()
+}
+
+// serveWS handles operations sent with graphql-transport-ws or graphql-ws subprotocol
+func (h *Handler) serveWS(rw http.ResponseWriter, req *http.Request, protocol wsProtocol) {
+	conn, err := h.upgrader.Upgrade(rw, req, http.Header{"Sec-Websocket-Protocol": {protocol.name}})
+	if err != nil {
+		klog.Error(err.Error())
+		return
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	c := &wsConnection{
+		h:          h,
+		protocol:   protocol,
+		conn:       conn,
+		ctx:        ctx,
+		operations: make(map[string]func()),
+	}
+	if h.rootObjectFn != nil {
+		c.rootObject = h.rootObjectFn(ctx, req)
+	}
+	initTimeout := time.AfterFunc(ConnectionInitWaitTimeout, func() {
+		c.lock.Lock()
+		acknowledged := c.acknowledged
+		c.lock.Unlock()
+		if !acknowledged {
+			c.close(closeInitTimeout, "Connection initialisation timeout")
+		}
+	})
+	defer initTimeout.Stop()
+	c.read()
+	c.stopAll()
+	conn.Close()
+}
+
+func (c *wsConnection) read() {
+	for {
+		_, b, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var msg wsMessage
+		if err := json.Unmarshal(b, &msg); err != nil || msg.Type == "" {
+			c.close(closeInvalidMessage, "Invalid message received")
+			return
+		}
+		if !c.handle(msg) {
+			return
+		}
+	}
+}
+
+// handle handles client message, returns false if connection was closed
+func (c *wsConnection) handle(msg wsMessage) bool {
+	switch msg.Type {
+	case connectionInitMessageType:
+		return c.init(msg.Payload)
+	case "ping":
+		return c.write(wsMessage{Type: "pong", Payload: msg.Payload}) == nil
+	case "pong":
+		return true
+	case "connection_terminate":
+		return false
+	case c.protocol.subscribe:
+		return c.subscribe(msg)
+	case c.protocol.stop:
+		c.stop(msg.ID)
+		return true
+	}
+	c.close(closeInvalidMessage, "Invalid message received")
+	return false
+}
+
+func (c *wsConnection) init(payload json.RawMessage) bool {
+	c.lock.Lock()
+	initialized := c.initialized
+	c.initialized = true
+	c.lock.Unlock()
+	if initialized {
+		c.close(closeTooManyInitRequests, "Too many initialisation requests")
+		return false
+	}
+	if len(payload) > 0 {
+		var params interface{}
+		if err := json.Unmarshal(payload, &params); err != nil {
+			c.close(closeInvalidMessage, "Invalid message received")
+			return false
+		}
+		c.ctx = withConnectionParams(c.ctx, params)
+	}
+	if err := c.write(wsMessage{Type: connectionAckMessageType}); err != nil {
+		return false
+	}
+	c.lock.Lock()
+	c.acknowledged = true
+	c.lock.Unlock()
+	if c.protocol.name == GraphQLWSProtocol {
+		return c.write(wsMessage{Type: "ka"}) == nil
+	}
+	return true
+}
+
+// withConnectionParams adds payload of connection_init message to protocol in context
+func withConnectionParams(ctx context.Context, params interface{}) context.Context {
+	protocol := map[string]interface{}{}
+	if p, ok := ctx.Value(router.ProtocolKey).(map[string]interface{}); ok {
+		for k, v := range p {
+			protocol[k] = v
+		}
+	}
+	protocol["connectionParams"] = params
+	return context.WithValue(ctx, router.ProtocolKey, protocol)
+}
+
+func (c *wsConnection) subscribe(msg wsMessage) bool {
+	c.lock.Lock()
+	acknowledged := c.acknowledged
+	_, exists := c.operations[msg.ID]
+	c.lock.Unlock()
+	switch {
+	case !acknowledged:
+		c.close(closeUnauthorized, "Unauthorized")
+		return false
+	case msg.ID == "":
+		c.close(closeInvalidMessage, "Invalid message received")
+		return false
+	case exists:
+		c.close(closeSubscriberExists, "Subscriber for "+msg.ID+" already exists")
+		return false
+	}
+	var opts requestOptions
+	if err := json.Unmarshal(msg.Payload, &opts); err != nil {
+		c.close(closeInvalidMessage, "Invalid message received")
+		return false
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.lock.Lock()
+	c.operations[msg.ID] = cancel
+	c.lock.Unlock()
+	go c.execute(ctx, msg.ID, &opts)
+	return true
+}
+
+// execute runs operation, results of blocking subscriptions are streamed until
+// reader finishes or operation is stopped by client
+func (c *wsConnection) execute(ctx context.Context, id string, opts *requestOptions) {
+	defer func() {
+		if r := recover(); r != nil {
+			klog.Error(r)
+			c.close(closeInternalServerError, "Internal server error")
+		}
+	}()
+	perr := c.h.checkRequest(opts)
+	if perr != nil {
+		c.finish(id, errorMessageType, c.protocol.errorPayload(perr.result().Errors))
+		return
+	}
+	result, sub := c.h.execute(ctx, opts, c.rootObject)
+	if result.Data == nil && len(result.Errors) > 0 {
+		c.finish(id, errorMessageType, c.protocol.errorPayload(result.Errors))
+		return
+	}
+	if sub == nil {
+		if c.next(id, result) == nil {
+			c.finish(id, completeMessageType, nil)
+		}
+		return
+	}
+	err := sub.stream(ctx, func(result *graphql.Result) error {
+		return c.next(id, result)
+	})
+	switch {
+	case ctx.Err() != nil:
+		// stopped by client or connection closed
+	case err != nil:
+		c.finish(id, errorMessageType, c.protocol.errorPayload(gqlerrors.FormatErrors(err)))
+	default:
+		c.finish(id, completeMessageType, nil)
+	}
+}
+
+func (c *wsConnection) next(id string, result *graphql.Result) error {
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return c.write(wsMessage{ID: id, Type: c.protocol.next, Payload: b})
+}
+
+// finish sends last message of operation unless it was stopped by client
+func (c *wsConnection) finish(id, messageType string, payload interface{}) {
+	c.lock.Lock()
+	cancel, ok := c.operations[id]
+	delete(c.operations, id)
+	c.lock.Unlock()
+	if !ok {
+		return
+	}
+	defer cancel()
+	msg := wsMessage{ID: id, Type: messageType}
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			klog.Error(err.Error())
+			return
+		}
+		msg.Payload = b
+	}
+	c.write(msg)
+}
+
+func (c *wsConnection) stop(id string) {
+	c.lock.Lock()
+	cancel, ok := c.operations[id]
+	delete(c.operations, id)
+	c.lock.Unlock()
+	if ok {
+		cancel()
+	}
+}
+
+func (c *wsConnection) stopAll() {
+	c.lock.Lock()
+	operations := c.operations
+	c.operations = make(map[string]func())
+	c.lock.Unlock()
+	for _, cancel := range operations {
+		cancel()
+	}
+}
+
+func (c *wsConnection) write(msg wsMessage) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	err := c.conn.WriteJSON(msg)
+	if err != nil {
+		klog.Error(err.Error())
+	}
+	return err
+}
+
+func (c *wsConnection) close(code int, reason string) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	c.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(code, reason),
+		time.Now().Add(time.Second),
+	)
+	c.conn.Close()
+}
@@ -0,0 +1,147 @@
+package handlers_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type sliceReader struct {
+	values []interface{}
+	value  interface{}
+}
+
+func (s *sliceReader) Error() error { return nil }
+func (s *sliceReader) Next() bool {
+	if len(s.values) == 0 {
+		return false
+	}
+	s.value, s.values = s.values[0], s.values[1:]
+	return true
+}
+func (s *sliceReader) Read() (interface{}, error) { return s.value, nil }
+func (s *sliceReader) Close() error               { return nil }
+
+// subscriptionServer serves a schema with counter subscription emitting 1 and 2 when
+// listen input is matched by listen
+func subscriptionServer(t *testing.T, listen func(driver.SubscriptionListenInput) bool) *httptest.Server {
+	env := router.Environment{
+		Provider: "transportws",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("SubscriptionListen", mock.MatchedBy(listen)).Return(driver.SubscriptionListenOutput{
+		Reader: &sliceReader{values: []interface{}{1, 2}},
+	})
+	for _, v := range []int{1, 2} {
+		v := v
+		mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+			return in.Function.Name == "counter" && in.SubscriptionPayload == v
+		})).Return(driver.FieldResolveOutput{Response: v})
+	}
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "field"
+	})).Return(driver.FieldResolveOutput{Response: "value"})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.field":          {Resolve: types.Function{Name: "field"}},
+			"Subscription.counter": {Resolve: types.Function{Name: "counter"}},
+		},
+		Schema: `type Query {
+			field: String
+		}
+		type Subscription {
+			counter: Int
+		}
+		schema {
+			query: Query
+			subscription: Subscription
+		}`,
+	})
+	require.NoError(t, err)
+	return httptest.NewServer(handlers.WithProtocolInContext(handlers.New(handlers.Config{Schema: &rt.Schema})))
+}
+
+func transportWSServer(t *testing.T) *httptest.Server {
+	return subscriptionServer(t, func(in driver.SubscriptionListenInput) bool {
+		protocol, _ := in.Protocol.(map[string]interface{})
+		params, _ := protocol["connectionParams"].(map[string]interface{})
+		return params["token"] == "secret"
+	})
+}
+
+func dialTransportWS(t *testing.T, srv *httptest.Server) *websocket.Conn {
+	dialer := websocket.Dialer{Subprotocols: []string{handlers.GraphQLTransportWSProtocol}}
+	conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, handlers.GraphQLTransportWSProtocol, resp.Header.Get("Sec-Websocket-Protocol"))
+	return conn
+}
+
+func TestHandlerTransportWS(t *testing.T) {
+	srv := transportWSServer(t)
+	defer srv.Close()
+	conn := dialTransportWS(t, srv)
+	defer conn.Close()
+	exchange := func(send interface{}, expected ...map[string]interface{}) {
+		if send != nil {
+			require.NoError(t, conn.WriteJSON(send))
+		}
+		for _, e := range expected {
+			var msg map[string]interface{}
+			require.NoError(t, conn.ReadJSON(&msg))
+			assert.Equal(t, e, msg)
+		}
+	}
+	exchange(
+		map[string]interface{}{"type": "connection_init", "payload": map[string]interface{}{"token": "secret"}},
+		map[string]interface{}{"type": "connection_ack"},
+	)
+	exchange(
+		map[string]interface{}{"type": "ping"},
+		map[string]interface{}{"type": "pong"},
+	)
+	exchange(
+		map[string]interface{}{"id": "1", "type": "subscribe", "payload": map[string]interface{}{"query": "subscription { counter }"}},
+		map[string]interface{}{"id": "1", "type": "next", "payload": map[string]interface{}{"data": map[string]interface{}{"counter": float64(1)}}},
+		map[string]interface{}{"id": "1", "type": "next", "payload": map[string]interface{}{"data": map[string]interface{}{"counter": float64(2)}}},
+		map[string]interface{}{"id": "1", "type": "complete"},
+	)
+	exchange(
+		map[string]interface{}{"id": "2", "type": "subscribe", "payload": map[string]interface{}{"query": "{ field }"}},
+		map[string]interface{}{"id": "2", "type": "next", "payload": map[string]interface{}{"data": map[string]interface{}{"field": "value"}}},
+		map[string]interface{}{"id": "2", "type": "complete"},
+	)
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"id": "3", "type": "subscribe", "payload": map[string]interface{}{"query": "{ missing }"}}))
+	var msg map[string]interface{}
+	require.NoError(t, conn.ReadJSON(&msg))
+	assert.Equal(t, "3", msg["id"])
+	assert.Equal(t, "error", msg["type"])
+	assert.IsType(t, []interface{}{}, msg["payload"])
+}
+
+func TestHandlerTransportWSUnauthorized(t *testing.T) {
+	srv := transportWSServer(t)
+	defer srv.Close()
+	conn := dialTransportWS(t, srv)
+	defer conn.Close()
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"id": "1", "type": "subscribe", "payload": map[string]interface{}{"query": "{ field }"}}))
+	_, _, err := conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, 4401))
+}
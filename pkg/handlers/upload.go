@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-editor/stucco/pkg/types"
+)
+
+const (
+	// InvalidUpload is an error code returned when multipart request is malformed
+	InvalidUpload = "INVALID_UPLOAD"
+	// UploadTooLarge is an error code returned when uploaded files exceed size limits
+	UploadTooLarge = "UPLOAD_TOO_LARGE"
+)
+
+const (
+	// DefaultMaxUploadSize is a default limit of multipart request size
+	DefaultMaxUploadSize = 32 << 20
+	// DefaultUploadMemory is a default number of bytes of uploaded files kept in memory
+	DefaultUploadMemory = 1 << 20
+)
+
+func isMultipart(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data")
+}
+
+func uploadLimit(v, def int64) int64 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+// errUploadTooLarge is returned when multipart request exceeds size limit
+var errUploadTooLarge = errors.New("request is too large")
+
+// limitedBody reads at most limit bytes of request body and records whether the
+// body was larger
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+	exceeded  bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.exceeded {
+		return 0, errUploadTooLarge
+	}
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.remaining {
+		n = int(b.remaining)
+		b.remaining = 0
+		b.exceeded = true
+		return n, errUploadTooLarge
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
+
+// multipartRequestOptions reads operations from GraphQL multipart request and sets files
+// in their variables. Request with an array of operations is batched, paths of files
+// in map are then prefixed with index of operation. Files larger than upload memory are
+// stored in temporary files removed by caller with req.MultipartForm.RemoveAll.
+func (h *Handler) multipartRequestOptions(rw http.ResponseWriter, req *http.Request) ([]*requestOptions, bool, *requestError) {
+	var body *limitedBody
+	if maxSize := uploadLimit(h.maxUploadSize, DefaultMaxUploadSize); maxSize > 0 {
+		body = &limitedBody{ReadCloser: req.Body, remaining: maxSize}
+		req.Body = body
+	}
+	if err := req.ParseMultipartForm(uploadLimit(h.uploadMemory, DefaultUploadMemory)); err != nil {
+		if body != nil && body.exceeded {
+			// rest of body is not read, so connection can not be reused
+			rw.Header().Set("Connection", "close")
+			return nil, false, &requestError{message: errUploadTooLarge.Error(), code: UploadTooLarge, status: http.StatusRequestEntityTooLarge}
+		}
+		return nil, false, &requestError{message: err.Error(), code: InvalidUpload, status: http.StatusBadRequest}
+	}
+	form := req.MultipartForm
+	operations := form.Value["operations"]
+	if len(operations) == 0 {
+		return nil, false, &requestError{message: "operations field is missing", code: InvalidUpload, status: http.StatusBadRequest}
+	}
+	var ops []*requestOptions
+	batched := strings.HasPrefix(strings.TrimSpace(operations[0]), "[")
+	if batched {
+		if err := json.Unmarshal([]byte(operations[0]), &ops); err != nil {
+			return nil, false, &requestError{message: err.Error(), code: InvalidUpload, status: http.StatusBadRequest}
+		}
+	} else {
+		var opts requestOptions
+		if err := json.Unmarshal([]byte(operations[0]), &opts); err != nil {
+			return nil, false, &requestError{message: err.Error(), code: InvalidUpload, status: http.StatusBadRequest}
+		}
+		ops = []*requestOptions{&opts}
+	}
+	var fileMap map[string][]string
+	if m := form.Value["map"]; len(m) > 0 {
+		if err := json.Unmarshal([]byte(m[0]), &fileMap); err != nil {
+			return nil, false, &requestError{message: err.Error(), code: InvalidUpload, status: http.StatusBadRequest}
+		}
+	}
+	for key, paths := range fileMap {
+		files := form.File[key]
+		if len(files) == 0 {
+			return nil, false, &requestError{message: "file " + key + " is missing", code: InvalidUpload, status: http.StatusBadRequest}
+		}
+		fh := files[0]
+		if h.maxUploadFileSize > 0 && fh.Size > h.maxUploadFileSize {
+			return nil, false, &requestError{
+				message: fmt.Sprintf("file %s is larger than %d bytes", fh.Filename, h.maxUploadFileSize),
+				code:    UploadTooLarge,
+				status:  http.StatusRequestEntityTooLarge,
+			}
+		}
+		upload := newUpload(fh)
+		for _, p := range paths {
+			opts, path, err := fileOperation(ops, batched, p)
+			if err == nil {
+				err = setOperationPath(opts, path, upload)
+			}
+			if err != nil {
+				return nil, false, &requestError{message: err.Error(), code: InvalidUpload, status: http.StatusBadRequest}
+			}
+		}
+	}
+	return ops, batched, nil
+}
+
+// fileOperation returns operation of file path and path of file in that operation.
+// Paths of batched operations start with index of operation, for example 0.variables.file.
+func fileOperation(ops []*requestOptions, batched bool, path string) (*requestOptions, string, error) {
+	if !batched {
+		return ops[0], path, nil
+	}
+	parts := strings.SplitN(path, ".", 2)
+	idx, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts) < 2 || idx < 0 || idx >= len(ops) || ops[idx] == nil {
+		return nil, "", fmt.Errorf("invalid file path %s", path)
+	}
+	return ops[idx], parts[1], nil
+}
+
+func newUpload(fh *multipart.FileHeader) *types.Upload {
+	return types.NewUpload(fh.Filename, fh.Header.Get("Content-Type"), fh.Size, func() (io.ReadCloser, error) {
+		return fh.Open()
+	})
+}
+
+// setOperationPath sets value in variables of operation at path in form of variables.a.0.b
+func setOperationPath(opts *requestOptions, path string, v interface{}) error {
+	parts := strings.Split(path, ".")
+	if len(parts) < 2 || parts[0] != "variables" || opts.Variables == nil {
+		return fmt.Errorf("invalid file path %s", path)
+	}
+	var parent interface{} = opts.Variables
+	for i, k := range parts[1:] {
+		last := i == len(parts)-2
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := p[k]; !ok {
+				return fmt.Errorf("invalid file path %s", path)
+			}
+			if last {
+				p[k] = v
+				return nil
+			}
+			parent = p[k]
+		case []interface{}:
+			idx, err := strconv.Atoi(k)
+			if err != nil || idx < 0 || idx >= len(p) {
+				return fmt.Errorf("invalid file path %s", path)
+			}
+			if last {
+				p[idx] = v
+				return nil
+			}
+			parent = p[idx]
+		default:
+			return fmt.Errorf("invalid file path %s", path)
+		}
+	}
+	return errors.New("unreachable")
+}
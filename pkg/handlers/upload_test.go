@@ -0,0 +1,165 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/handlers"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func uploadHandler(t *testing.T, cfg handlers.Config) http.Handler {
+	env := router.Environment{
+		Provider: "upload",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		u, ok := in.Arguments["file"].(*types.Upload)
+		if !ok || in.Function.Name != "upload" {
+			return false
+		}
+		b, err := u.Content()
+		return err == nil && u.Filename == "0.txt" && u.MimeType == "application/octet-stream" && string(b) == "content"
+	})).Return(driver.FieldResolveOutput{Response: "uploaded"})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Mutation.upload": {
+				Resolve: types.Function{Name: "upload"},
+			},
+		},
+		Schema: `scalar Upload
+		type Query {
+			field: String
+		}
+		type Mutation {
+			upload(file: Upload!): String
+		}
+		schema {
+			query: Query
+			mutation: Mutation
+		}`,
+	})
+	require.NoError(t, err)
+	cfg.Schema = &rt.Schema
+	return handlers.New(cfg)
+}
+
+func multipartRequest(t *testing.T, operations, fileMap string, files map[string]string) *http.Request {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	require.NoError(t, w.WriteField("operations", operations))
+	require.NoError(t, w.WriteField("map", fileMap))
+	for k, v := range files {
+		fw, err := w.CreateFormFile(k, k+".txt")
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(v))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	req := httptest.NewRequest(http.MethodPost, "/graphql", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req
+}
+
+func TestHandlerUpload(t *testing.T) {
+	data := []struct {
+		title      string
+		cfg        handlers.Config
+		operations string
+		fileMap    string
+		files      map[string]string
+		status     int
+		expected   string
+	}{
+		{
+			title:      "Uploads",
+			operations: `{"query":"mutation($file: Upload!) { upload(file: $file) }","variables":{"file":null}}`,
+			fileMap:    `{"0":["variables.file"]}`,
+			files:      map[string]string{"0": "content"},
+			status:     http.StatusOK,
+			expected:   `{"data":{"upload":"uploaded"}}`,
+		},
+		{
+			title:      "MissingFile",
+			operations: `{"query":"mutation($file: Upload!) { upload(file: $file) }","variables":{"file":null}}`,
+			fileMap:    `{"0":["variables.file"]}`,
+			status:     http.StatusBadRequest,
+			expected:   `{"data":null,"errors":[{"message":"file 0 is missing","locations":null,"extensions":{"code":"INVALID_UPLOAD"}}]}`,
+		},
+		{
+			title:      "InvalidPath",
+			operations: `{"query":"mutation($file: Upload!) { upload(file: $file) }","variables":{"file":null}}`,
+			fileMap:    `{"0":["variables.other"]}`,
+			files:      map[string]string{"0": "content"},
+			status:     http.StatusBadRequest,
+			expected:   `{"data":null,"errors":[{"message":"invalid file path variables.other","locations":null,"extensions":{"code":"INVALID_UPLOAD"}}]}`,
+		},
+		{
+			title:      "BatchedUploads",
+			operations: `[{"query":"mutation($file: Upload!) { upload(file: $file) }","variables":{"file":null}},{"query":"mutation($file: Upload!) { upload(file: $file) }","variables":{"file":null}}]`,
+			fileMap:    `{"0":["0.variables.file","1.variables.file"]}`,
+			files:      map[string]string{"0": "content"},
+			status:     http.StatusOK,
+			expected:   `[{"data":{"upload":"uploaded"}},{"data":{"upload":"uploaded"}}]`,
+		},
+		{
+			title:      "BatchedInvalidPath",
+			operations: `[{"query":"mutation($file: Upload!) { upload(file: $file) }","variables":{"file":null}}]`,
+			fileMap:    `{"0":["1.variables.file"]}`,
+			files:      map[string]string{"0": "content"},
+			status:     http.StatusBadRequest,
+			expected:   `{"data":null,"errors":[{"message":"invalid file path 1.variables.file","locations":null,"extensions":{"code":"INVALID_UPLOAD"}}]}`,
+		},
+		{
+			title:      "FileTooLarge",
+			cfg:        handlers.Config{MaxUploadFileSize: 4},
+			operations: `{"query":"mutation($file: Upload!) { upload(file: $file) }","variables":{"file":null}}`,
+			fileMap:    `{"0":["variables.file"]}`,
+			files:      map[string]string{"0": "content"},
+			status:     http.StatusRequestEntityTooLarge,
+			expected:   `{"data":null,"errors":[{"message":"file 0.txt is larger than 4 bytes","locations":null,"extensions":{"code":"UPLOAD_TOO_LARGE"}}]}`,
+		},
+		{
+			title:      "RequestTooLarge",
+			cfg:        handlers.Config{MaxUploadSize: 16},
+			operations: `{"query":"mutation($file: Upload!) { upload(file: $file) }","variables":{"file":null}}`,
+			fileMap:    `{"0":["variables.file"]}`,
+			files:      map[string]string{"0": "content"},
+			status:     http.StatusRequestEntityTooLarge,
+			expected:   `{"data":null,"errors":[{"message":"request is too large","locations":null,"extensions":{"code":"UPLOAD_TOO_LARGE"}}]}`,
+		},
+	}
+	for i := range data {
+		tt := data[i]
+		t.Run(tt.title, func(t *testing.T) {
+			h := uploadHandler(t, tt.cfg)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, multipartRequest(t, tt.operations, tt.fileMap, tt.files))
+			assert.Equal(t, tt.status, rec.Code)
+			b, err := ioutil.ReadAll(rec.Body)
+			require.NoError(t, err)
+			var expected, actual interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.expected), &expected))
+			require.NoError(t, json.Unmarshal(b, &actual))
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
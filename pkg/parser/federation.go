@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// federationDefinitions are types and directives of Apollo Federation subgraph schema
+var federationDefinitions = []struct {
+	name       string
+	definition string
+}{
+	{"_Any", "scalar _Any"},
+	{"_FieldSet", "scalar _FieldSet"},
+	{"_Service", "type _Service { sdl: String }"},
+	{"@external", "directive @external on FIELD_DEFINITION"},
+	{"@requires", "directive @requires(fields: _FieldSet!) on FIELD_DEFINITION"},
+	{"@provides", "directive @provides(fields: _FieldSet!) on FIELD_DEFINITION"},
+	{"@key", "directive @key(fields: _FieldSet!) on OBJECT | INTERFACE"},
+	{"@extends", "directive @extends on OBJECT | INTERFACE"},
+	{"@shareable", "directive @shareable on OBJECT | FIELD_DEFINITION"},
+}
+
+func hasDirective(dirs []*ast.Directive, name string) bool {
+	for _, d := range dirs {
+		if d.Name != nil && d.Name.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// federation adds federation types and directives, _Entity union of types with @key directive
+// and _service and _entities fields of query type to document. Extensions of entities
+// defined in other subgraphs become definitions.
+func (p *Parser) federation() error {
+	defined := map[string]bool{}
+	for _, d := range p.document.Definitions {
+		switch v := d.(type) {
+		case namedDefinition:
+			defined[v.GetName().Value] = true
+		case *ast.DirectiveDefinition:
+			defined["@"+v.Name.Value] = true
+		}
+	}
+	var entities []string
+	for i, d := range p.document.Definitions {
+		switch v := d.(type) {
+		case *ast.ObjectDefinition:
+			if hasDirective(v.Directives, "key") {
+				entities = append(entities, v.Name.Value)
+			}
+		case *ast.ObjectExtensionDefinition:
+			if !hasDirective(v.Definition.Directives, "key") {
+				continue
+			}
+			if !defined[v.Definition.Name.Value] {
+				p.document.Definitions[i] = v.Definition
+				defined[v.Definition.Name.Value] = true
+			}
+			entities = append(entities, v.Definition.Name.Value)
+		}
+	}
+	var sdl []string
+	for _, def := range federationDefinitions {
+		if !defined[def.name] {
+			sdl = append(sdl, def.definition)
+		}
+	}
+	fields := "_service: _Service!"
+	if len(entities) > 0 {
+		sdl = append(sdl, "union _Entity = "+strings.Join(entities, " | "))
+		fields += " _entities(representations: [_Any!]!): [_Entity]!"
+	}
+	query := p.queryTypeName()
+	if defined[query] {
+		sdl = append(sdl, "extend type "+query+" { "+fields+" }")
+	} else {
+		sdl = append(sdl, "type "+query+" { "+fields+" }")
+	}
+	doc, err := parser.Parse(parser.ParseParams{Source: strings.Join(sdl, "\n")})
+	if err != nil {
+		return err
+	}
+	p.document.Definitions = append(p.document.Definitions, doc.Definitions...)
+	return nil
+}
+
+// queryTypeName returns name of query type from schema definition or Query
+func (p *Parser) queryTypeName() string {
+	for _, d := range p.document.Definitions {
+		if s, ok := d.(*ast.SchemaDefinition); ok {
+			for _, op := range s.OperationTypes {
+				if op.Operation == "query" && op.Type != nil {
+					return op.Type.Name.Value
+				}
+			}
+		}
+	}
+	return "Query"
+}
@@ -0,0 +1,54 @@
+package parser
+
+import "github.com/graphql-go/graphql"
+
+// DeferDirective marks fragment which can be delivered after initial result of operation
+var DeferDirective = graphql.NewDirective(graphql.DirectiveConfig{
+	Name:        "defer",
+	Description: "Directs the executor to deliver this fragment after initial result of operation.",
+	Locations: []string{
+		graphql.DirectiveLocationFragmentSpread,
+		graphql.DirectiveLocationInlineFragment,
+	},
+	Args: graphql.FieldConfigArgument{
+		"if": &graphql.ArgumentConfig{
+			Type:         graphql.Boolean,
+			DefaultValue: true,
+			Description:  "Deferred when true.",
+		},
+		"label": &graphql.ArgumentConfig{
+			Type:        graphql.String,
+			Description: "Identifies deferred fragment in subsequent payloads.",
+		},
+	},
+})
+
+// StreamDirective marks list field which items can be delivered after initial result of operation
+var StreamDirective = graphql.NewDirective(graphql.DirectiveConfig{
+	Name:        "stream",
+	Description: "Directs the executor to deliver items of this list field after initial result of operation.",
+	Locations: []string{
+		graphql.DirectiveLocationField,
+	},
+	Args: graphql.FieldConfigArgument{
+		"if": &graphql.ArgumentConfig{
+			Type:         graphql.Boolean,
+			DefaultValue: true,
+			Description:  "Streamed when true.",
+		},
+		"label": &graphql.ArgumentConfig{
+			Type:        graphql.String,
+			Description: "Identifies streamed field in subsequent payloads.",
+		},
+		"initialCount": &graphql.ArgumentConfig{
+			Type:         graphql.Int,
+			DefaultValue: 0,
+			Description:  "Number of items delivered in initial result.",
+		},
+	},
+})
+
+// directives returns directives supported by schema
+func directives() []*graphql.Directive {
+	return append(append([]*graphql.Directive{}, graphql.SpecifiedDirectives...), DeferDirective, StreamDirective)
+}
@@ -12,6 +12,25 @@ type namedDefintion interface {
 	GetName() *ast.Name
 }
 
+// literalValue returns value of literal with objects and lists converted to maps and slices
+func literalValue(v ast.Value) interface{} {
+	switch vv := v.(type) {
+	case *ast.ObjectValue:
+		o := make(map[string]interface{}, len(vv.Fields))
+		for _, f := range vv.Fields {
+			o[f.Name.Value] = literalValue(f.Value)
+		}
+		return o
+	case *ast.ListValue:
+		l := make([]interface{}, 0, len(vv.Values))
+		for _, e := range vv.Values {
+			l = append(l, literalValue(e))
+		}
+		return l
+	}
+	return v.GetValue()
+}
+
 func customDefinition(p *Parser, d ast.Definition) (gt graphql.Type, err error) {
 	t, ok := d.(namedDefintion)
 	if !ok {
@@ -29,6 +48,9 @@ func customDefinition(p *Parser, d ast.Definition) (gt graphql.Type, err error)
 		parseValue := func(v interface{}) interface{} {
 			return v
 		}
+		if t.Name.Value == UploadScalar {
+			serialize, parseValue = serializeUpload, parseUpload
+		}
 		if fn, ok := p.Scalars[t.Name.Value]; ok {
 			if fn.Serialize != nil {
 				serialize = fn.Serialize
@@ -41,7 +63,7 @@ func customDefinition(p *Parser, d ast.Definition) (gt graphql.Type, err error)
 			Name:       t.Name.Value,
 			ParseValue: parseValue,
 			ParseLiteral: func(v ast.Value) interface{} {
-				return parseValue(v.GetValue())
+				return parseValue(literalValue(v))
 			},
 			Serialize: serialize,
 		}
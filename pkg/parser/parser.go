@@ -329,9 +329,20 @@ func (p *Parser) Parse(source string) (graphql.Schema, error) {
 		p.document = nil
 		return graphql.Schema{}, err
 	}
+	if p.Federation {
+		if err := p.federation(); err != nil {
+			return graphql.Schema{}, err
+		}
+	}
 	return p.analyze()
 }
 
+// Document returns parsed schema document with extensions merged
+// into definitions
+func (p *Parser) Document() *ast.Document {
+	return p.document
+}
+
 // ScalarFunctions definitions
 type ScalarFunctions struct {
 	// Parse scalar function definition
@@ -347,6 +358,8 @@ type Config struct {
 	Resolvers  map[string]graphql.FieldResolveFn
 	Scalars    map[string]ScalarFunctions
 	Unions     map[string]graphql.ResolveTypeFn
+	// Federation adds Apollo Federation subgraph types, directives and root fields to schema
+	Federation bool
 }
 
 // NewParser creates a schema Parser with a config
@@ -9,6 +9,7 @@ import (
 	"github.com/graphql-go/graphql/language/ast"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func starwarsSchema() graphql.Schema {
@@ -1121,3 +1122,37 @@ func TestSetsUserFieldResolve(t *testing.T) {
 		Source: "source",
 	})
 }
+
+func TestFederation(t *testing.T) {
+	p := parser.NewParser(parser.Config{Federation: true})
+	schema, err := p.Parse(`
+type Review @key(fields: "id") {
+	id: ID!
+	body: String
+	author: User @provides(fields: "name")
+}
+extend type User @key(fields: "id") {
+	id: ID! @external
+	name: String @external
+	reviews: [Review] @requires(fields: "name")
+}
+extend type Query {
+	reviews: [Review]
+}
+`)
+	require.NoError(t, err)
+	query := schema.QueryType()
+	assert.Contains(t, query.Fields(), "reviews")
+	assert.Contains(t, query.Fields(), "_service")
+	require.Contains(t, query.Fields(), "_entities")
+	assert.Equal(t, "[_Entity]!", query.Fields()["_entities"].Type.String())
+	entity, ok := schema.Type("_Entity").(*graphql.Union)
+	require.True(t, ok)
+	var names []string
+	for _, e := range entity.Types() {
+		names = append(names, e.Name())
+	}
+	assert.ElementsMatch(t, []string{"Review", "User"}, names)
+	assert.NotNil(t, schema.Type("_Any"))
+	assert.Contains(t, schema.Type("User").(*graphql.Object).Fields(), "reviews")
+}
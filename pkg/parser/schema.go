@@ -40,7 +40,8 @@ func (s schema) parse(p *Parser) (graphql.Schema, error) {
 		return graphql.Schema{}, err
 	}
 	sCfg := graphql.SchemaConfig{
-		Query: o,
+		Query:      o,
+		Directives: directives(),
 	}
 	if s.Mutation != nil {
 		o, err = s.Mutation.config(p)
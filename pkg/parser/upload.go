@@ -0,0 +1,23 @@
+package parser
+
+import "github.com/graphql-editor/stucco/pkg/types"
+
+// UploadScalar is a name of scalar with built in implementation accepting files
+// of multipart requests unless schema config defines its functions
+const UploadScalar = "Upload"
+
+// parseUpload accepts only files set in variables by multipart request handler
+func parseUpload(v interface{}) interface{} {
+	if u, ok := v.(*types.Upload); ok {
+		return u
+	}
+	return nil
+}
+
+// serializeUpload returns file name of upload, files can not be returned in response
+func serializeUpload(v interface{}) interface{} {
+	if u, ok := v.(*types.Upload); ok {
+		return u.Filename
+	}
+	return nil
+}
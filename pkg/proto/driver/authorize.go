@@ -29,6 +29,17 @@ func MakeAuthorizeRequest(input driver.AuthorizeInput) (r *protoMessages.Authori
 		VariableValues: variableValues,
 		Protocol:       protocol,
 	}
+	if err == nil {
+		r.Claims, err = claimsToValue(input.Claims)
+	}
+	if err == nil && input.Info != nil {
+		r.Field, err = MakeFieldResolveRequest(driver.FieldResolveInput{
+			Function:  input.Function,
+			Source:    input.Source,
+			Arguments: input.Arguments,
+			Info:      *input.Info,
+		})
+	}
 	return
 }
 
@@ -36,8 +47,14 @@ func MakeAuthorizeRequest(input driver.AuthorizeInput) (r *protoMessages.Authori
 func MakeAuthorizeOutput(resp *protoMessages.AuthorizeResponse) (out driver.AuthorizeOutput) {
 	out.Response = resp.GetResponse()
 	if rerr := resp.GetError(); rerr != nil {
-		out.Error = &driver.Error{Message: rerr.GetMsg()}
+		out.Error = MakeDriverError(rerr)
+		return out
 	}
+	claims, err := valueToAny(nil, resp.GetClaims())
+	if err != nil {
+		out.Error = &driver.Error{Message: err.Error()}
+	}
+	out.Claims = claims
 	return out
 }
 
@@ -61,6 +78,17 @@ func MakeAuthorizeInput(input *protoMessages.AuthorizeRequest) (f driver.Authori
 		VariableValues: variableValues,
 		Protocol:       protocol,
 	}
+	if f.Claims, err = valueToAny(nil, input.GetClaims()); err != nil {
+		return
+	}
+	if field := input.GetField(); field != nil {
+		var fieldInput driver.FieldResolveInput
+		if fieldInput, err = MakeFieldResolveInput(field); err == nil {
+			f.Source = fieldInput.Source
+			f.Arguments = fieldInput.Arguments
+			f.Info = &fieldInput.Info
+		}
+	}
 	return
 }
 
@@ -71,6 +99,16 @@ func MakeAuthorizeResponse(resp bool) *protoMessages.AuthorizeResponse {
 	}
 }
 
+// MakeAuthorizeClaimsResponse creates a protoMessages.AuthorizeResponse with claims of authorized request
+func MakeAuthorizeClaimsResponse(resp bool, claims interface{}) (*protoMessages.AuthorizeResponse, error) {
+	r := MakeAuthorizeResponse(resp)
+	var err error
+	if r.Claims, err = claimsToValue(claims); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
 // ReadAuthorizeInput reads io.Reader until io.EOF and returs driver.AuthorizeInput
 func ReadAuthorizeInput(r io.Reader) (driver.AuthorizeInput, error) {
 	var err error
@@ -121,3 +159,16 @@ func WriteAuthorizeOutput(w io.Writer, r bool) error {
 	}
 	return err
 }
+
+// WriteAuthorizeClaimsOutput writes AuthorizeOutput with claims into io.Writer
+func WriteAuthorizeClaimsOutput(w io.Writer, r bool, claims interface{}) error {
+	req, err := MakeAuthorizeClaimsResponse(r, claims)
+	var b []byte
+	if err == nil {
+		b, err = protobuf.Marshal(req)
+	}
+	if err == nil {
+		_, err = w.Write(b)
+	}
+	return err
+}
@@ -0,0 +1,67 @@
+package protodriver
+
+import (
+	"errors"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+)
+
+// MakeProtoError creates protoMessages.Error from error. If err is a *driver.Error, its code,
+// extensions and path are kept.
+func MakeProtoError(err error) *protoMessages.Error {
+	if err == nil {
+		return nil
+	}
+	protoErr := &protoMessages.Error{Msg: err.Error()}
+	var derr *driver.Error
+	if !errors.As(err, &derr) {
+		return protoErr
+	}
+	protoErr.Code = derr.Code
+	// extensions and path that cannot be encoded are dropped, message and code
+	// are enough for client to handle an error
+	if ext, err := mapOfAnyToMapOfValue(derr.Extensions); err == nil {
+		protoErr.Extensions = ext
+	}
+	if len(derr.Path) > 0 {
+		path := make([]*protoMessages.Value, 0, len(derr.Path))
+		for _, p := range derr.Path {
+			v, err := anyToValue(p)
+			if err != nil {
+				path = nil
+				break
+			}
+			path = append(path, v)
+		}
+		protoErr.Path = path
+	}
+	return protoErr
+}
+
+// MakeDriverError creates driver.Error from protoMessages.Error
+func MakeDriverError(protoErr *protoMessages.Error) *driver.Error {
+	if protoErr == nil {
+		return nil
+	}
+	derr := &driver.Error{
+		Message: protoErr.GetMsg(),
+		Code:    protoErr.GetCode(),
+	}
+	if ext, err := mapOfValueToMapOfAny(nil, protoErr.GetExtensions()); err == nil {
+		derr.Extensions = ext
+	}
+	if protoPath := protoErr.GetPath(); len(protoPath) > 0 {
+		path := make([]interface{}, 0, len(protoPath))
+		for _, pv := range protoPath {
+			v, err := valueToAny(nil, pv)
+			if err != nil {
+				path = nil
+				break
+			}
+			path = append(path, v)
+		}
+		derr.Path = path
+	}
+	return derr
+}
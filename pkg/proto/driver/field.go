@@ -74,6 +74,7 @@ func MakeFieldResolveRequest(input driver.FieldResolveInput) (r *protoMessages.F
 		Protocol:            protocol,
 		SubscriptionPayload: subscriptionPayload,
 	}
+	r.Claims, err = claimsToValue(input.Claims)
 	return
 }
 
@@ -84,7 +85,7 @@ func MakeFieldResolveOutput(resp *protoMessages.FieldResolveResponse) (out drive
 	if err != nil {
 		out.Error = &driver.Error{Message: err.Error()}
 	} else if rerr := resp.GetError(); rerr != nil {
-		out.Error = &driver.Error{Message: rerr.GetMsg()}
+		out.Error = MakeDriverError(rerr)
 	}
 	return out
 }
@@ -141,6 +142,10 @@ func MakeFieldResolveInput(input *protoMessages.FieldResolveRequest) (f driver.F
 	if err != nil {
 		return
 	}
+	claims, err := valueToAny(nil, input.GetClaims())
+	if err != nil {
+		return
+	}
 	f = driver.FieldResolveInput{
 		Function: types.Function{
 			Name: input.GetFunction().GetName(),
@@ -150,6 +155,7 @@ func MakeFieldResolveInput(input *protoMessages.FieldResolveRequest) (f driver.F
 		Arguments:           args,
 		Protocol:            protocol,
 		SubscriptionPayload: subscriptionPayload,
+		Claims:              claims,
 	}
 	return
 }
@@ -161,9 +167,7 @@ func MakeFieldResolveResponse(resp interface{}) *protoMessages.FieldResolveRespo
 	if err == nil {
 		protoResponse.Response = v
 	} else {
-		protoResponse.Error = &protoMessages.Error{
-			Msg: err.Error(),
-		}
+		protoResponse.Error = MakeProtoError(err)
 	}
 	return &protoResponse
 }
@@ -0,0 +1,126 @@
+package protodriver
+
+import (
+	"io"
+	"io/ioutil"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// MakeFieldResolveBatchRequest creates a new proto FieldResolveBatchRequest from driver batch input
+func MakeFieldResolveBatchRequest(input driver.FieldResolveBatchInput) (r *protoMessages.FieldResolveBatchRequest, err error) {
+	reqs := make([]*protoMessages.FieldResolveRequest, 0, len(input.Inputs))
+	for _, in := range input.Inputs {
+		var req *protoMessages.FieldResolveRequest
+		if req, err = MakeFieldResolveRequest(in); err != nil {
+			return
+		}
+		reqs = append(reqs, req)
+	}
+	r = &protoMessages.FieldResolveBatchRequest{
+		Requests: reqs,
+	}
+	return
+}
+
+// MakeFieldResolveBatchOutput creates new driver.FieldResolveBatchOutput from proto response
+func MakeFieldResolveBatchOutput(resp *protoMessages.FieldResolveBatchResponse) (out driver.FieldResolveBatchOutput) {
+	if rerr := resp.GetError(); rerr != nil {
+		out.Error = MakeDriverError(rerr)
+		return
+	}
+	out.Outputs = make([]driver.FieldResolveOutput, 0, len(resp.GetResponses()))
+	for _, r := range resp.GetResponses() {
+		out.Outputs = append(out.Outputs, MakeFieldResolveOutput(r))
+	}
+	return
+}
+
+// MakeFieldResolveBatchInput creates driver.FieldResolveBatchInput from protoMessages.FieldResolveBatchRequest
+func MakeFieldResolveBatchInput(input *protoMessages.FieldResolveBatchRequest) (f driver.FieldResolveBatchInput, err error) {
+	f.Inputs = make([]driver.FieldResolveInput, 0, len(input.GetRequests()))
+	for _, req := range input.GetRequests() {
+		var in driver.FieldResolveInput
+		if in, err = MakeFieldResolveInput(req); err != nil {
+			return driver.FieldResolveBatchInput{}, err
+		}
+		f.Inputs = append(f.Inputs, in)
+	}
+	return
+}
+
+// FieldResolveBatchResult is a result of single field resolution in batch
+type FieldResolveBatchResult struct {
+	Response interface{}
+	Error    error
+}
+
+// MakeFieldResolveBatchResponse creates a protoMessages.FieldResolveBatchResponse from
+// results of field resolutions in batch
+func MakeFieldResolveBatchResponse(results []FieldResolveBatchResult) *protoMessages.FieldResolveBatchResponse {
+	resps := make([]*protoMessages.FieldResolveResponse, 0, len(results))
+	for _, r := range results {
+		if r.Error != nil {
+			resps = append(resps, &protoMessages.FieldResolveResponse{
+				Error: MakeProtoError(r.Error),
+			})
+			continue
+		}
+		resps = append(resps, MakeFieldResolveResponse(r.Response))
+	}
+	return &protoMessages.FieldResolveBatchResponse{
+		Responses: resps,
+	}
+}
+
+// ReadFieldResolveBatchInput reads io.Reader until io.EOF and returns driver.FieldResolveBatchInput
+func ReadFieldResolveBatchInput(r io.Reader) (driver.FieldResolveBatchInput, error) {
+	var err error
+	var b []byte
+	var out driver.FieldResolveBatchInput
+	protoMsg := new(protoMessages.FieldResolveBatchRequest)
+	if b, err = ioutil.ReadAll(r); err == nil {
+		if err = protobuf.Unmarshal(b, protoMsg); err == nil {
+			out, err = MakeFieldResolveBatchInput(protoMsg)
+		}
+	}
+	return out, err
+}
+
+// WriteFieldResolveBatchInput writes FieldResolveBatchInput into io.Writer
+func WriteFieldResolveBatchInput(w io.Writer, input driver.FieldResolveBatchInput) error {
+	req, err := MakeFieldResolveBatchRequest(input)
+	if err == nil {
+		var b []byte
+		b, err = protobuf.Marshal(req)
+		if err == nil {
+			_, err = w.Write(b)
+		}
+	}
+	return err
+}
+
+// ReadFieldResolveBatchOutput reads io.Reader until io.EOF and returns driver.FieldResolveBatchOutput
+func ReadFieldResolveBatchOutput(r io.Reader) (driver.FieldResolveBatchOutput, error) {
+	var err error
+	var b []byte
+	var out driver.FieldResolveBatchOutput
+	protoMsg := new(protoMessages.FieldResolveBatchResponse)
+	if b, err = ioutil.ReadAll(r); err == nil {
+		if err = protobuf.Unmarshal(b, protoMsg); err == nil {
+			out = MakeFieldResolveBatchOutput(protoMsg)
+		}
+	}
+	return out, err
+}
+
+// WriteFieldResolveBatchOutput writes FieldResolveBatchResponse into io.Writer
+func WriteFieldResolveBatchOutput(w io.Writer, resp *protoMessages.FieldResolveBatchResponse) error {
+	b, err := protobuf.Marshal(resp)
+	if err == nil {
+		_, err = w.Write(b)
+	}
+	return err
+}
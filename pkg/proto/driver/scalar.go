@@ -1,7 +1,6 @@
 package protodriver
 
 import (
-	"fmt"
 	"io"
 	"io/ioutil"
 
@@ -15,12 +14,17 @@ import (
 // MakeScalarParseRequest creates new protoMessages.ScalarParseRequest from driver.ScalarParseInput
 func MakeScalarParseRequest(input driver.ScalarParseInput) (req *protoMessages.ScalarParseRequest, err error) {
 	v, err := anyToValue(input.Value)
+	var claims *protoMessages.Value
+	if err == nil {
+		claims, err = claimsToValue(input.Claims)
+	}
 	if err == nil {
 		req = &protoMessages.ScalarParseRequest{
 			Function: &protoMessages.Function{
 				Name: input.Function.Name,
 			},
-			Value: v,
+			Value:  v,
+			Claims: claims,
 		}
 	}
 	return
@@ -32,14 +36,13 @@ func MakeScalarParseOutput(resp *protoMessages.ScalarParseResponse) driver.Scala
 	var err error
 	var r interface{}
 	if respErr := resp.GetError(); respErr != nil {
-		err = fmt.Errorf(respErr.GetMsg())
-	} else {
-		r, err = valueToAny(nil, resp.GetValue())
-		if err == nil {
-			out.Response = r
-		}
+		out.Error = MakeDriverError(respErr)
+		return out
 	}
-	if err != nil {
+	r, err = valueToAny(nil, resp.GetValue())
+	if err == nil {
+		out.Response = r
+	} else {
 		out.Error = &driver.Error{Message: err.Error()}
 	}
 	return out
@@ -48,12 +51,17 @@ func MakeScalarParseOutput(resp *protoMessages.ScalarParseResponse) driver.Scala
 // MakeScalarSerializeRequest creates new protoMessages.ScalarSerializeRequest from driver.ScalarSerializeInput
 func MakeScalarSerializeRequest(input driver.ScalarSerializeInput) (req *protoMessages.ScalarSerializeRequest, err error) {
 	v, err := anyToValue(input.Value)
+	var claims *protoMessages.Value
+	if err == nil {
+		claims, err = claimsToValue(input.Claims)
+	}
 	if err == nil {
 		req = &protoMessages.ScalarSerializeRequest{
 			Function: &protoMessages.Function{
 				Name: input.Function.Name,
 			},
-			Value: v,
+			Value:  v,
+			Claims: claims,
 		}
 	}
 	return
@@ -65,14 +73,13 @@ func MakeScalarSerializeOutput(resp *protoMessages.ScalarSerializeResponse) driv
 	var err error
 	var r interface{}
 	if respErr := resp.GetError(); respErr != nil {
-		err = fmt.Errorf(respErr.GetMsg())
-	} else {
-		r, err = valueToAny(nil, resp.GetValue())
-		if err == nil {
-			out.Response = r
-		}
+		out.Error = MakeDriverError(respErr)
+		return out
 	}
-	if err != nil {
+	r, err = valueToAny(nil, resp.GetValue())
+	if err == nil {
+		out.Response = r
+	} else {
 		out.Error = &driver.Error{Message: err.Error()}
 	}
 	return out
@@ -82,12 +89,17 @@ func MakeScalarSerializeOutput(resp *protoMessages.ScalarSerializeResponse) driv
 func MakeScalarParseInput(req *protoMessages.ScalarParseRequest) (driver.ScalarParseInput, error) {
 	var input driver.ScalarParseInput
 	val, err := valueToAny(nil, req.GetValue())
+	var claims interface{}
+	if err == nil {
+		claims, err = valueToAny(nil, req.GetClaims())
+	}
 	if err == nil {
 		input = driver.ScalarParseInput{
 			Function: types.Function{
 				Name: req.GetFunction().GetName(),
 			},
-			Value: val,
+			Value:  val,
+			Claims: claims,
 		}
 	}
 	return input, err
@@ -98,9 +110,7 @@ func MakeScalarParseResponse(value interface{}) *protoMessages.ScalarParseRespon
 	var protoResponse protoMessages.ScalarParseResponse
 	v, err := anyToValue(value)
 	if err != nil {
-		protoResponse.Error = &protoMessages.Error{
-			Msg: err.Error(),
-		}
+		protoResponse.Error = MakeProtoError(err)
 	} else {
 		protoResponse.Value = v
 	}
@@ -111,12 +121,17 @@ func MakeScalarParseResponse(value interface{}) *protoMessages.ScalarParseRespon
 func MakeScalarSerializeInput(req *protoMessages.ScalarSerializeRequest) (driver.ScalarSerializeInput, error) {
 	var input driver.ScalarSerializeInput
 	val, err := valueToAny(nil, req.GetValue())
+	var claims interface{}
+	if err == nil {
+		claims, err = valueToAny(nil, req.GetClaims())
+	}
 	if err == nil {
 		input = driver.ScalarSerializeInput{
 			Function: types.Function{
 				Name: req.GetFunction().GetName(),
 			},
-			Value: val,
+			Value:  val,
+			Claims: claims,
 		}
 	}
 	return input, err
@@ -127,9 +142,7 @@ func MakeScalarSerializeResponse(value interface{}) *protoMessages.ScalarSeriali
 	var protoResponse protoMessages.ScalarSerializeResponse
 	v, err := anyToValue(value)
 	if err != nil {
-		protoResponse.Error = &protoMessages.Error{
-			Msg: err.Error(),
-		}
+		protoResponse.Error = MakeProtoError(err)
 	} else {
 		protoResponse.Value = v
 	}
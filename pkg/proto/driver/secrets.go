@@ -26,9 +26,7 @@ func MakeSetSecretsRequest(input driver.SetSecretsInput) *protoMessages.SetSecre
 func MakeSetSecretsResponse(err error) *protoMessages.SetSecretsResponse {
 	s := new(protoMessages.SetSecretsResponse)
 	if err != nil {
-		s.Error = &protoMessages.Error{
-			Msg: err.Error(),
-		}
+		s.Error = MakeProtoError(err)
 	}
 	return s
 }
@@ -49,11 +47,7 @@ func MakeSetSecretsInput(req *protoMessages.SetSecretsRequest) driver.SetSecrets
 // MakeSetSecretsOutput creates driver.SetSecretsOutput from protoMessages.SetSecretsResponse
 func MakeSetSecretsOutput(resp *protoMessages.SetSecretsResponse) driver.SetSecretsOutput {
 	var out driver.SetSecretsOutput
-	if resp.GetError() != nil {
-		out.Error = &driver.Error{
-			Message: resp.GetError().GetMsg(),
-		}
-	}
+	out.Error = MakeDriverError(resp.GetError())
 	return out
 }
 
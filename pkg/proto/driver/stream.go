@@ -0,0 +1,207 @@
+package protodriver
+
+import (
+	"context"
+	"io"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+	protoDriverService "github.com/graphql-editor/stucco_proto/go/driver_service"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+)
+
+func makeProtoStreamInfo(input driver.StreamInfo) (r *protoMessages.StreamInfo, err error) {
+	variableValues, err := mapOfAnyToMapOfValue(input.VariableValues)
+	if err != nil {
+		return
+	}
+	od, err := makeProtoOperationDefinition(input.Operation)
+	if err != nil {
+		return
+	}
+	rp, err := makeProtoResponsePath(input.Path)
+	if err != nil {
+		return
+	}
+	r = &protoMessages.StreamInfo{
+		FieldName:      input.FieldName,
+		Path:           rp,
+		ReturnType:     makeProtoTypeRef(input.ReturnType),
+		ParentType:     makeProtoTypeRef(input.ParentType),
+		VariableValues: variableValues,
+		Operation:      od,
+	}
+	return
+}
+
+// MakeStreamRequest creates a new proto StreamRequest from driver input
+func MakeStreamRequest(input driver.StreamInput) (r *protoMessages.StreamRequest, err error) {
+	args, err := mapOfAnyToMapOfValue(input.Arguments)
+	if err != nil {
+		return
+	}
+	info, err := makeProtoStreamInfo(input.Info)
+	if err != nil {
+		return
+	}
+	protocol, err := anyToValue(input.Protocol)
+	if err != nil {
+		return
+	}
+	r = &protoMessages.StreamRequest{
+		Function: &protoMessages.Function{
+			Name: input.Function.Name,
+		},
+		Arguments: args,
+		Info:      info,
+		Secrets:   input.Secrets,
+		Protocol:  protocol,
+	}
+	return
+}
+
+func makeDriverStreamInfo(input *protoMessages.StreamInfo) (f driver.StreamInfo, err error) {
+	variables := input.GetVariableValues()
+	variableValues, err := mapOfValueToMapOfAny(nil, variables)
+	if err != nil {
+		return
+	}
+	variables = initVariablesWithDefaults(variables, input.GetOperation())
+	od, err := makeDriverOperationDefinition(variables, input.GetOperation())
+	if err != nil {
+		return
+	}
+	rp, err := makeDriverResponsePath(variables, input.GetPath())
+	if err != nil {
+		return
+	}
+	f = driver.StreamInfo{
+		FieldName:      input.GetFieldName(),
+		Path:           rp,
+		ReturnType:     makeDriverTypeRef(input.GetReturnType()),
+		ParentType:     makeDriverTypeRef(input.GetParentType()),
+		VariableValues: variableValues,
+		Operation:      od,
+	}
+	return
+}
+
+// MakeStreamInput creates driver.StreamInput from protoMessages.StreamRequest
+func MakeStreamInput(input *protoMessages.StreamRequest) (f driver.StreamInput, err error) {
+	variables := initVariablesWithDefaults(
+		input.GetInfo().GetVariableValues(),
+		input.GetInfo().GetOperation(),
+	)
+	protocol, err := valueToAny(nil, input.GetProtocol())
+	if err != nil {
+		return
+	}
+	info, err := makeDriverStreamInfo(input.GetInfo())
+	if err != nil {
+		return
+	}
+	args, err := mapOfValueToMapOfAny(variables, input.GetArguments())
+	if err != nil {
+		return
+	}
+	f = driver.StreamInput{
+		Function: types.Function{
+			Name: input.GetFunction().GetName(),
+		},
+		Arguments: args,
+		Info:      info,
+		Secrets:   input.GetSecrets(),
+		Protocol:  protocol,
+	}
+	return
+}
+
+// MakeStreamMessage creates new driver.StreamMessage from proto message
+func MakeStreamMessage(msg *protoMessages.StreamMessage) (out driver.StreamMessage) {
+	var err error
+	out.Response, err = valueToAny(nil, msg.GetResponse())
+	if err != nil {
+		out.Error = &driver.Error{Message: err.Error()}
+	} else if rerr := msg.GetError(); rerr != nil {
+		out.Error = MakeDriverError(rerr)
+	}
+	return out
+}
+
+// MakeProtoStreamMessage creates a protoMessages.StreamMessage from a value
+func MakeProtoStreamMessage(resp interface{}) *protoMessages.StreamMessage {
+	protoMessage := protoMessages.StreamMessage{}
+	v, err := anyToValue(resp)
+	if err == nil {
+		protoMessage.Response = v
+	} else {
+		protoMessage.Error = MakeProtoError(err)
+	}
+	return &protoMessage
+}
+
+type streamReader struct {
+	cancel   context.CancelFunc
+	messages chan driver.StreamMessage
+	msg      driver.StreamMessage
+	err      error
+}
+
+// NewStreamReader creates new stream reader for Stream
+func NewStreamReader(client protoDriverService.DriverClient, req *protoMessages.StreamRequest) (driver.StreamReader, error) {
+	return NewStreamReaderContext(context.Background(), client, req)
+}
+
+// NewStreamReaderContext creates new stream reader for Stream which is closed
+// when context is done
+func NewStreamReaderContext(ctx context.Context, client protoDriverService.DriverClient, req *protoMessages.StreamRequest) (driver.StreamReader, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	streamClient, err := client.Stream(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	r := &streamReader{
+		cancel:   cancel,
+		messages: make(chan driver.StreamMessage),
+	}
+	go func() {
+		defer close(r.messages)
+		for {
+			m, err := streamClient.Recv()
+			if err != nil {
+				// stream closed by server or reader, nothing to report
+				if err != io.EOF && ctx.Err() == nil {
+					r.err = err
+				}
+				return
+			}
+			select {
+			case r.messages <- MakeStreamMessage(m):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return r, nil
+}
+
+// Error returns error that ended the stream, it must be called only
+// after Next returned false
+func (r *streamReader) Error() error {
+	return r.err
+}
+
+func (r *streamReader) Next() bool {
+	msg, ok := <-r.messages
+	r.msg = msg
+	return ok
+}
+
+func (r *streamReader) Read() driver.StreamMessage {
+	return r.msg
+}
+
+func (r *streamReader) Close() {
+	r.cancel()
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/graphql-editor/stucco/pkg/types"
 	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
 	"k8s.io/klog"
 )
@@ -45,6 +46,14 @@ func encodeFuncForType(t reflect.Type) func(reflect.Value) (*protoMessages.Value
 			return v.Interface().(ValueMarshaler).MarshalValue()
 		}
 	}
+	if t == uploadType {
+		return func(v reflect.Value) (*protoMessages.Value, error) {
+			if v.IsNil() {
+				return nilValue, nil
+			}
+			return uploadToValue(v.Interface().(*types.Upload))
+		}
+	}
 	switch t.Kind() {
 	case reflect.Interface:
 		// can't really do anything with interface, needs full check
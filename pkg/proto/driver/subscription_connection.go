@@ -31,6 +31,9 @@ func MakeSubscriptionConnectionRequest(input driver.SubscriptionConnectionInput)
 	proto, err := anyToValue(input.Protocol)
 	if err == nil {
 		ret.Protocol = proto
+		ret.Claims, err = claimsToValue(input.Claims)
+	}
+	if err == nil {
 		r = &ret
 	}
 	return
@@ -43,7 +46,7 @@ func MakeSubscriptionConnectionOutput(resp *protoMessages.SubscriptionConnection
 	if err != nil {
 		out.Error = &driver.Error{Message: err.Error()}
 	} else if rerr := resp.GetError(); rerr != nil {
-		out.Error = &driver.Error{Message: rerr.GetMsg()}
+		out.Error = MakeDriverError(rerr)
 	}
 	return out
 }
@@ -70,6 +73,9 @@ func MakeSubscriptionConnectionInput(input *protoMessages.SubscriptionConnection
 	if pr := input.GetProtocol(); pr != nil {
 		f.Protocol, err = valueToAny(nil, pr)
 	}
+	if err == nil {
+		f.Claims, err = valueToAny(nil, input.GetClaims())
+	}
 	return
 }
 
@@ -80,9 +86,7 @@ func MakeSubscriptionConnectionResponse(resp interface{}) *protoMessages.Subscri
 	if err == nil {
 		protoResponse.Response = v
 	} else {
-		protoResponse.Error = &protoMessages.Error{
-			Msg: err.Error(),
-		}
+		protoResponse.Error = MakeProtoError(err)
 	}
 	return &protoResponse
 }
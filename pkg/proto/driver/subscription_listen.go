@@ -34,6 +34,9 @@ func MakeSubscriptionListenRequest(input driver.SubscriptionListenInput) (r *pro
 	if err == nil {
 		ret.Protocol, err = anyToValue(input.Protocol)
 	}
+	if err == nil {
+		ret.Claims, err = claimsToValue(input.Claims)
+	}
 	if err == nil {
 		r = &ret
 	}
@@ -56,10 +59,17 @@ type subscriptionReader struct {
 
 // NewSubscriptionReader creates new subscription reader for SubscriptionListen
 func NewSubscriptionReader(client protoDriverService.DriverClient, req *protoMessages.SubscriptionListenRequest) (driver.SubscriptionListenReader, error) {
+	return NewSubscriptionReaderContext(context.Background(), client, req)
+}
+
+// NewSubscriptionReaderContext creates new subscription reader for SubscriptionListen
+// which is closed when context is done
+func NewSubscriptionReaderContext(ctx context.Context, client protoDriverService.DriverClient, req *protoMessages.SubscriptionListenRequest) (driver.SubscriptionListenReader, error) {
 	var r subscriptionReader
-	r.ctx, r.cancel = context.WithCancel(context.Background())
+	r.ctx, r.cancel = context.WithCancel(ctx)
 	subClient, err := client.SubscriptionListen(r.ctx, req)
 	if err != nil {
+		r.cancel()
 		return nil, err
 	}
 	r.sigCh = make(chan sigType, 10)
@@ -134,6 +144,9 @@ func MakeSubscriptionListenInput(input *protoMessages.SubscriptionListenRequest)
 	if pr := input.GetProtocol(); pr != nil {
 		f.Protocol, err = valueToAny(nil, pr)
 	}
+	if err == nil {
+		f.Claims, err = valueToAny(nil, input.GetClaims())
+	}
 	return
 }
 
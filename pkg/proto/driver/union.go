@@ -56,6 +56,7 @@ func MakeUnionResolveTypeRequest(input driver.UnionResolveTypeInput) (r *protoMe
 		Value: value,
 		Info:  info,
 	}
+	r.Claims, err = claimsToValue(input.Claims)
 	return
 }
 
@@ -63,9 +64,7 @@ func MakeUnionResolveTypeRequest(input driver.UnionResolveTypeInput) (r *protoMe
 func MakeUnionResolveTypeOutput(resp *protoMessages.UnionResolveTypeResponse) driver.UnionResolveTypeOutput {
 	out := driver.UnionResolveTypeOutput{}
 	if err := resp.GetError(); err != nil {
-		out.Error = &driver.Error{
-			Message: err.GetMsg(),
-		}
+		out.Error = MakeDriverError(err)
 	} else if t := resp.GetType(); t != nil {
 		out.Type = *makeDriverTypeRef(t)
 	}
@@ -108,12 +107,17 @@ func MakeUnionResolveTypeInput(input *protoMessages.UnionResolveTypeRequest) (i
 	if err != nil {
 		return
 	}
+	claims, err := valueToAny(nil, input.GetClaims())
+	if err != nil {
+		return
+	}
 	i = driver.UnionResolveTypeInput{
 		Function: types.Function{
 			Name: input.GetFunction().GetName(),
 		},
-		Value: val,
-		Info:  info,
+		Value:  val,
+		Info:   info,
+		Claims: claims,
 	}
 	return
 }
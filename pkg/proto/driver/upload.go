@@ -0,0 +1,38 @@
+package protodriver
+
+import (
+	"reflect"
+
+	"github.com/graphql-editor/stucco/pkg/types"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+)
+
+// uploadToValue encodes upload as an object with file content as bytes
+func uploadToValue(u *types.Upload) (*protoMessages.Value, error) {
+	content, err := u.Content()
+	if err != nil {
+		return nil, err
+	}
+	return &protoMessages.Value{
+		TestValue: &protoMessages.Value_O{
+			O: &protoMessages.ObjectValue{
+				Props: map[string]*protoMessages.Value{
+					"filename": {TestValue: &protoMessages.Value_S{S: u.Filename}},
+					"mimeType": {TestValue: &protoMessages.Value_S{S: u.MimeType}},
+					"size":     {TestValue: &protoMessages.Value_I{I: u.Size}},
+					"content":  {TestValue: &protoMessages.Value_Any{Any: content}},
+				},
+			},
+		},
+	}, nil
+}
+
+var uploadType = reflect.TypeOf((*types.Upload)(nil))
+
+// upload returns upload held by value
+func upload(v reflect.Value) (*types.Upload, bool) {
+	if v.Type() != uploadType || v.IsNil() || !v.CanInterface() {
+		return nil, false
+	}
+	return v.Interface().(*types.Upload), true
+}
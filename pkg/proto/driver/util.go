@@ -167,6 +167,9 @@ func anyToValueReflected(v reflect.Value) (*protoMessages.Value, error) {
 		}
 		v = v.Elem()
 	}
+	if u, ok := upload(v); ok {
+		return uploadToValue(u)
+	}
 	// Flatten GraphQL value types to an actual value
 	v, err := flattenValue(v)
 	if err != nil {
@@ -222,6 +225,15 @@ func anyToValue(v interface{}) (*protoMessages.Value, error) {
 	return anyToValueReflected(reflect.ValueOf(v))
 }
 
+// claimsToValue converts claims returned by authorize function to Value,
+// requests without claims do not carry them
+func claimsToValue(claims interface{}) (*protoMessages.Value, error) {
+	if claims == nil {
+		return nil, nil
+	}
+	return anyToValue(claims)
+}
+
 func mapOfValueToMapOfAny(variables map[string]*protoMessages.Value, m map[string]*protoMessages.Value) (many map[string]interface{}, err error) {
 	if len(m) == 0 {
 		return
@@ -688,6 +688,79 @@ func FieldResolveClientTestData() []FieldResolveClientTest {
 				Response: "response",
 			},
 		},
+		{
+			Title: "MarshalingUploadArgument",
+			Input: driver.FieldResolveInput{
+				Function: types.Function{
+					Name: "function",
+				},
+				Info: driver.FieldResolveInfo{},
+				Arguments: types.Arguments{
+					"file": types.NewUploadFromBytes("file.txt", "text/plain", []byte("content")),
+				},
+			},
+			ProtoRequest: &protoMessages.FieldResolveRequest{
+				Function: &protoMessages.Function{
+					Name: "function",
+				},
+				Info: &protoMessages.FieldResolveInfo{},
+				Arguments: map[string]*protoMessages.Value{
+					"file": {
+						TestValue: &protoMessages.Value_O{
+							O: &protoMessages.ObjectValue{
+								Props: map[string]*protoMessages.Value{
+									"filename": {
+										TestValue: &protoMessages.Value_S{
+											S: "file.txt",
+										},
+									},
+									"mimeType": {
+										TestValue: &protoMessages.Value_S{
+											S: "text/plain",
+										},
+									},
+									"size": {
+										TestValue: &protoMessages.Value_I{
+											I: int64(7),
+										},
+									},
+									"content": {
+										TestValue: &protoMessages.Value_Any{
+											Any: []byte("content"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Source: &protoMessages.Value{
+					TestValue: &protoMessages.Value_Nil{
+						Nil: true,
+					},
+				},
+				Protocol: &protoMessages.Value{
+					TestValue: &protoMessages.Value_Nil{
+						Nil: true,
+					},
+				},
+				SubscriptionPayload: &protoMessages.Value{
+					TestValue: &protoMessages.Value_Nil{
+						Nil: true,
+					},
+				},
+			},
+			ProtoResponse: &protoMessages.FieldResolveResponse{
+				Response: &protoMessages.Value{
+					TestValue: &protoMessages.Value_S{
+						S: "response",
+					},
+				},
+			},
+			Expected: driver.FieldResolveOutput{
+				Response: "response",
+			},
+		},
 		{
 			Title: "UnmarshalingResponse",
 			Input: driver.FieldResolveInput{
@@ -46,6 +46,45 @@ func ScalarParseClientTestData() []ScalarParseClientTest {
 			}},
 			Expected: driver.ScalarParseOutput{Response: "scalar"},
 		},
+		{
+			Title: "SendsClaims",
+			Input: driver.ScalarParseInput{
+				Function: types.Function{
+					Name: "function",
+				},
+				Value:  "scalar",
+				Claims: map[string]interface{}{"sub": "user"},
+			},
+			ProtoRequest: &protoMessages.ScalarParseRequest{
+				Function: &protoMessages.Function{
+					Name: "function",
+				},
+				Value: &protoMessages.Value{
+					TestValue: &protoMessages.Value_S{
+						S: "scalar",
+					},
+				},
+				Claims: &protoMessages.Value{
+					TestValue: &protoMessages.Value_O{
+						O: &protoMessages.ObjectValue{
+							Props: map[string]*protoMessages.Value{
+								"sub": {
+									TestValue: &protoMessages.Value_S{
+										S: "user",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ProtoResponse: &protoMessages.ScalarParseResponse{Value: &protoMessages.Value{
+				TestValue: &protoMessages.Value_S{
+					S: "scalar",
+				},
+			}},
+			Expected: driver.ScalarParseOutput{Response: "scalar"},
+		},
 		{
 			Title: "ReturnsUserError",
 			Input: driver.ScalarParseInput{
@@ -186,6 +225,45 @@ func ScalarSerializeClientTestData() []ScalarSerializeClientTest {
 			}},
 			Expected: driver.ScalarSerializeOutput{Response: "scalar"},
 		},
+		{
+			Title: "SendsClaims",
+			Input: driver.ScalarSerializeInput{
+				Function: types.Function{
+					Name: "function",
+				},
+				Value:  "scalar",
+				Claims: map[string]interface{}{"sub": "user"},
+			},
+			ProtoRequest: &protoMessages.ScalarSerializeRequest{
+				Function: &protoMessages.Function{
+					Name: "function",
+				},
+				Value: &protoMessages.Value{
+					TestValue: &protoMessages.Value_S{
+						S: "scalar",
+					},
+				},
+				Claims: &protoMessages.Value{
+					TestValue: &protoMessages.Value_O{
+						O: &protoMessages.ObjectValue{
+							Props: map[string]*protoMessages.Value{
+								"sub": {
+									TestValue: &protoMessages.Value_S{
+										S: "user",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ProtoResponse: &protoMessages.ScalarSerializeResponse{Value: &protoMessages.Value{
+				TestValue: &protoMessages.Value_S{
+					S: "scalar",
+				},
+			}},
+			Expected: driver.ScalarSerializeOutput{Response: "scalar"},
+		},
 		{
 			Title: "ReturnsUserError",
 			Input: driver.ScalarSerializeInput{
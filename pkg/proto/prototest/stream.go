@@ -0,0 +1,301 @@
+package prototest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+	protoMessages "github.com/graphql-editor/stucco_proto/go/messages"
+)
+
+// StreamClientTest is basic struct for testing clients implementing proto
+type StreamClientTest struct {
+	Title         string
+	Input         driver.StreamInput
+	ProtoRequest  *protoMessages.StreamRequest
+	ProtoMessages []*protoMessages.StreamMessage
+	// ProtoError is returned by stream after all messages were received,
+	// if nil stream ends with io.EOF
+	ProtoError    error
+	Expected      []driver.StreamMessage
+	ExpectedError error
+}
+
+// StreamClientTestData is a data for testing streaming of proto clients
+func StreamClientTestData() []StreamClientTest {
+	return []StreamClientTest{
+		{
+			Title: "MarshalingInput",
+			Input: driver.StreamInput{
+				Function: types.Function{
+					Name: "function",
+				},
+				Arguments: types.Arguments{
+					"arg": "value",
+				},
+				Info: driver.StreamInfo{
+					FieldName: "field",
+					Path: &types.ResponsePath{
+						Key: "field",
+						Prev: &types.ResponsePath{
+							Key: "fieldPrev",
+						},
+					},
+					ReturnType: &types.TypeRef{
+						List: &types.TypeRef{
+							NonNull: &types.TypeRef{
+								Name: "String",
+							},
+						},
+					},
+					ParentType: &types.TypeRef{
+						Name: "SomeType",
+					},
+					Operation: &types.OperationDefinition{
+						Operation: "query",
+						Name:      "streamField",
+						SelectionSet: types.Selections{
+							types.Selection{
+								Name: "field",
+							},
+						},
+					},
+					VariableValues: map[string]interface{}{
+						"var": "value",
+					},
+				},
+				Secrets: driver.Secrets{
+					"SECRET": "value",
+				},
+				Protocol: map[string]interface{}{
+					"headers": map[string]interface{}{
+						"Authorization": "Bearer token",
+					},
+				},
+			},
+			ProtoRequest: &protoMessages.StreamRequest{
+				Function: &protoMessages.Function{
+					Name: "function",
+				},
+				Arguments: map[string]*protoMessages.Value{
+					"arg": {
+						TestValue: &protoMessages.Value_S{
+							S: "value",
+						},
+					},
+				},
+				Info: &protoMessages.StreamInfo{
+					FieldName: "field",
+					Path: &protoMessages.ResponsePath{
+						Key: &protoMessages.Value{
+							TestValue: &protoMessages.Value_S{
+								S: "field",
+							},
+						},
+						Prev: &protoMessages.ResponsePath{
+							Key: &protoMessages.Value{
+								TestValue: &protoMessages.Value_S{
+									S: "fieldPrev",
+								},
+							},
+						},
+					},
+					ReturnType: &protoMessages.TypeRef{
+						TestTyperef: &protoMessages.TypeRef_List{
+							List: &protoMessages.TypeRef{
+								TestTyperef: &protoMessages.TypeRef_NonNull{
+									NonNull: &protoMessages.TypeRef{
+										TestTyperef: &protoMessages.TypeRef_Name{
+											Name: "String",
+										},
+									},
+								},
+							},
+						},
+					},
+					ParentType: &protoMessages.TypeRef{
+						TestTyperef: &protoMessages.TypeRef_Name{
+							Name: "SomeType",
+						},
+					},
+					Operation: &protoMessages.OperationDefinition{
+						Operation: "query",
+						Name:      "streamField",
+						SelectionSet: []*protoMessages.Selection{
+							{
+								Name: "field",
+							},
+						},
+					},
+					VariableValues: map[string]*protoMessages.Value{
+						"var": {
+							TestValue: &protoMessages.Value_S{
+								S: "value",
+							},
+						},
+					},
+				},
+				Secrets: map[string]string{
+					"SECRET": "value",
+				},
+				Protocol: &protoMessages.Value{
+					TestValue: &protoMessages.Value_O{
+						O: &protoMessages.ObjectValue{
+							Props: map[string]*protoMessages.Value{
+								"headers": {
+									TestValue: &protoMessages.Value_O{
+										O: &protoMessages.ObjectValue{
+											Props: map[string]*protoMessages.Value{
+												"Authorization": {
+													TestValue: &protoMessages.Value_S{
+														S: "Bearer token",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ProtoMessages: []*protoMessages.StreamMessage{
+				{
+					Response: &protoMessages.Value{
+						TestValue: &protoMessages.Value_S{
+							S: "response",
+						},
+					},
+				},
+			},
+			Expected: []driver.StreamMessage{
+				{
+					Response: "response",
+				},
+			},
+		},
+		{
+			Title: "UnmarshalingMessages",
+			Input: driver.StreamInput{
+				Function: types.Function{
+					Name: "function",
+				},
+			},
+			ProtoRequest: &protoMessages.StreamRequest{
+				Function: &protoMessages.Function{
+					Name: "function",
+				},
+				Info: &protoMessages.StreamInfo{},
+				Protocol: &protoMessages.Value{
+					TestValue: &protoMessages.Value_Nil{
+						Nil: true,
+					},
+				},
+			},
+			ProtoMessages: []*protoMessages.StreamMessage{
+				{
+					Response: &protoMessages.Value{
+						TestValue: &protoMessages.Value_O{
+							O: &protoMessages.ObjectValue{
+								Props: map[string]*protoMessages.Value{
+									"intValue": {
+										TestValue: &protoMessages.Value_I{
+											I: int64(1),
+										},
+									},
+									"stringValue": {
+										TestValue: &protoMessages.Value_S{
+											S: "string",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Response: &protoMessages.Value{
+						TestValue: &protoMessages.Value_A{
+							A: &protoMessages.ArrayValue{
+								Items: []*protoMessages.Value{
+									{
+										TestValue: &protoMessages.Value_I{
+											I: int64(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Error: &protoMessages.Error{
+						Msg: "message error",
+					},
+				},
+			},
+			Expected: []driver.StreamMessage{
+				{
+					Response: map[string]interface{}{
+						"intValue":    int64(1),
+						"stringValue": "string",
+					},
+				},
+				{
+					Response: []interface{}{int64(1)},
+				},
+				{
+					Error: &driver.Error{
+						Message: "message error",
+					},
+				},
+			},
+		},
+		{
+			Title: "ReturnsStreamError",
+			Input: driver.StreamInput{
+				Function: types.Function{
+					Name: "function",
+				},
+			},
+			ProtoRequest: &protoMessages.StreamRequest{
+				Function: &protoMessages.Function{
+					Name: "function",
+				},
+				Info: &protoMessages.StreamInfo{},
+				Protocol: &protoMessages.Value{
+					TestValue: &protoMessages.Value_Nil{
+						Nil: true,
+					},
+				},
+			},
+			ProtoMessages: []*protoMessages.StreamMessage{
+				{
+					Response: &protoMessages.Value{
+						TestValue: &protoMessages.Value_S{
+							S: "response",
+						},
+					},
+				},
+			},
+			ProtoError: errors.New("stream error"),
+			Expected: []driver.StreamMessage{
+				{
+					Response: "response",
+				},
+			},
+			ExpectedError: errors.New("stream error"),
+		},
+	}
+}
+
+// RunStreamClientTests runs all client tests on a function
+func RunStreamClientTests(t *testing.T, f func(t *testing.T, tt StreamClientTest)) {
+	for _, tt := range StreamClientTestData() {
+		t.Run(tt.Title, func(t *testing.T) {
+			f(t, tt)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package driver
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/http"
@@ -31,7 +32,12 @@ type ProtobufClient struct {
 
 // Post implemention for azure worker protobuf communication
 func (p ProtobufClient) Post(url, contentType string, body io.Reader) (resp *http.Response, err error) {
-	req, err := http.NewRequest(http.MethodPost, url, body)
+	return p.PostContext(context.Background(), url, contentType, body)
+}
+
+// PostContext implemention for azure worker protobuf communication
+func (p ProtobufClient) PostContext(ctx context.Context, url, contentType string, body io.Reader) (resp *http.Response, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
 	if err == nil {
 		req.Header.Add("content-type", contentType)
 		client := p.HTTPClient
@@ -43,12 +49,14 @@ func (p ProtobufClient) Post(url, contentType string, body io.Reader) (resp *htt
 	return
 }
 
-// New returns new driver using protobuf protocol
+// New returns new driver using protobuf protocol. Batches of field resolutions
+// are sent to worker in a single request.
 func (p ProtobufClient) New(u, f string) driver.Driver {
 	p.FunctionName = f
 	return &protohttp.Client{
 		HTTPClient: p,
 		URL:        u,
+		Batch:      true,
 	}
 }
 
@@ -131,99 +139,164 @@ func (d *Driver) functionClient(f types.Function) (client driver.Driver, derr *d
 
 // Authorize implements driver.Driver
 func (d *Driver) Authorize(in driver.AuthorizeInput) driver.AuthorizeOutput {
+	return d.AuthorizeContext(context.Background(), in)
+}
+
+// AuthorizeContext implements driver.ContextDriver
+func (d *Driver) AuthorizeContext(ctx context.Context, in driver.AuthorizeInput) driver.AuthorizeOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.AuthorizeOutput{
 			Error: err,
 		}
 	}
-	return client.Authorize(in)
+	return driver.WithContext(client).AuthorizeContext(ctx, in)
 }
 
 // FieldResolve implements driver.Driver
 func (d *Driver) FieldResolve(in driver.FieldResolveInput) driver.FieldResolveOutput {
+	return d.FieldResolveContext(context.Background(), in)
+}
+
+// FieldResolveContext implements driver.ContextDriver
+func (d *Driver) FieldResolveContext(ctx context.Context, in driver.FieldResolveInput) driver.FieldResolveOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.FieldResolveOutput{
 			Error: err,
 		}
 	}
-	return client.FieldResolve(in)
+	return driver.WithContext(client).FieldResolveContext(ctx, in)
+}
+
+// FieldResolveBatch implements driver.BatchDriver
+func (d *Driver) FieldResolveBatch(in driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	return d.FieldResolveBatchContext(context.Background(), in)
+}
+
+// FieldResolveBatchContext implements driver.BatchDriver. All inputs in batch
+// are sent to the function of the first input.
+func (d *Driver) FieldResolveBatchContext(ctx context.Context, in driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	if len(in.Inputs) == 0 {
+		return driver.FieldResolveBatchOutput{}
+	}
+	client, err := d.functionClient(in.Inputs[0].Function)
+	if err != nil {
+		return driver.FieldResolveBatchOutput{
+			Error: err,
+		}
+	}
+	return driver.FieldResolveBatch(ctx, client, in)
 }
 
 // InterfaceResolveType implements driver.Driver
 func (d *Driver) InterfaceResolveType(in driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
+	return d.InterfaceResolveTypeContext(context.Background(), in)
+}
+
+// InterfaceResolveTypeContext implements driver.ContextDriver
+func (d *Driver) InterfaceResolveTypeContext(ctx context.Context, in driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.InterfaceResolveTypeOutput{
 			Error: err,
 		}
 	}
-	return client.InterfaceResolveType(in)
+	return driver.WithContext(client).InterfaceResolveTypeContext(ctx, in)
 }
 
 // ScalarParse implements driver.Driver
 func (d *Driver) ScalarParse(in driver.ScalarParseInput) driver.ScalarParseOutput {
+	return d.ScalarParseContext(context.Background(), in)
+}
+
+// ScalarParseContext implements driver.ContextDriver
+func (d *Driver) ScalarParseContext(ctx context.Context, in driver.ScalarParseInput) driver.ScalarParseOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.ScalarParseOutput{
 			Error: err,
 		}
 	}
-	return client.ScalarParse(in)
+	return driver.WithContext(client).ScalarParseContext(ctx, in)
 }
 
 // ScalarSerialize implements driver.Driver
 func (d *Driver) ScalarSerialize(in driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
+	return d.ScalarSerializeContext(context.Background(), in)
+}
+
+// ScalarSerializeContext implements driver.ContextDriver
+func (d *Driver) ScalarSerializeContext(ctx context.Context, in driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.ScalarSerializeOutput{
 			Error: err,
 		}
 	}
-	return client.ScalarSerialize(in)
+	return driver.WithContext(client).ScalarSerializeContext(ctx, in)
 }
 
 // UnionResolveType implements driver.Driver
 func (d *Driver) UnionResolveType(in driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
+	return d.UnionResolveTypeContext(context.Background(), in)
+}
+
+// UnionResolveTypeContext implements driver.ContextDriver
+func (d *Driver) UnionResolveTypeContext(ctx context.Context, in driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.UnionResolveTypeOutput{
 			Error: err,
 		}
 	}
-	return client.UnionResolveType(in)
+	return driver.WithContext(client).UnionResolveTypeContext(ctx, in)
 }
 
 // Stream implements driver.Driver
 func (d *Driver) Stream(in driver.StreamInput) driver.StreamOutput {
+	return d.StreamContext(context.Background(), in)
+}
+
+// StreamContext implements driver.ContextDriver
+func (d *Driver) StreamContext(ctx context.Context, in driver.StreamInput) driver.StreamOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.StreamOutput{
 			Error: err,
 		}
 	}
-	return client.Stream(in)
+	return driver.WithContext(client).StreamContext(ctx, in)
 }
 
 // SubscriptionConnection implements driver.Driver
 func (d *Driver) SubscriptionConnection(in driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
+	return d.SubscriptionConnectionContext(context.Background(), in)
+}
+
+// SubscriptionConnectionContext implements driver.ContextDriver
+func (d *Driver) SubscriptionConnectionContext(ctx context.Context, in driver.SubscriptionConnectionInput) driver.SubscriptionConnectionOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.SubscriptionConnectionOutput{
 			Error: err,
 		}
 	}
-	return client.SubscriptionConnection(in)
+	return driver.WithContext(client).SubscriptionConnectionContext(ctx, in)
 }
 
 // SubscriptionListen implements driver.Driver
 func (d *Driver) SubscriptionListen(in driver.SubscriptionListenInput) driver.SubscriptionListenOutput {
+	return d.SubscriptionListenContext(context.Background(), in)
+}
+
+// SubscriptionListenContext implements driver.ContextDriver
+func (d *Driver) SubscriptionListenContext(ctx context.Context, in driver.SubscriptionListenInput) driver.SubscriptionListenOutput {
 	client, err := d.functionClient(in.Function)
 	if err != nil {
 		return driver.SubscriptionListenOutput{
 			Error: err,
 		}
 	}
-	return client.SubscriptionListen(in)
+	return driver.WithContext(client).SubscriptionListenContext(ctx, in)
 }
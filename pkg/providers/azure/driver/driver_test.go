@@ -2,13 +2,17 @@ package driver_test
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
 	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/driver/protohttp"
 	azuredriver "github.com/graphql-editor/stucco/pkg/providers/azure/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -119,3 +123,42 @@ func TestDriver(t *testing.T) {
 
 	mockWorkerClient.AssertNumberOfCalls(t, "New", 6)
 }
+
+type fieldResolveMuxer struct {
+	protohttp.Muxer
+}
+
+func (fieldResolveMuxer) FieldResolve(in driver.FieldResolveInput) (interface{}, error) {
+	return in.Source, nil
+}
+
+func TestDriverFieldResolveBatch(t *testing.T) {
+	handler := &protohttp.Handler{
+		Muxer: fieldResolveMuxer{},
+	}
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		handler.ServeHTTP(rw, req)
+	}))
+	defer srv.Close()
+	d := azuredriver.Driver{
+		BaseURL: srv.URL,
+		WorkerClient: azuredriver.ProtobufClient{
+			HTTPClient: srv.Client(),
+		},
+	}
+	out := d.FieldResolveBatch(driver.FieldResolveBatchInput{
+		Inputs: []driver.FieldResolveInput{
+			{Function: types.Function{Name: "function"}, Source: "a"},
+			{Function: types.Function{Name: "function"}, Source: "b"},
+		},
+	})
+	assert.Equal(t, driver.FieldResolveBatchOutput{
+		Outputs: []driver.FieldResolveOutput{
+			{Response: "a"},
+			{Response: "b"},
+		},
+	}, out)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,130 @@
+/*
+Package pubsub implements an in-process publish/subscribe broker with named topics.
+
+Subscriptions implement driver.SubscriptionListenReader, so they can be used as a
+source of blocking subscriptions. Each subscription buffers a limited number of
+payloads, publishing never blocks and payloads published to a subscriber which
+buffer is full are dropped for that subscriber.
+*/
+package pubsub
+
+import "sync"
+
+// DefaultBufferSize is a default number of payloads buffered for each subscriber
+const DefaultBufferSize = 16
+
+// Broker delivers payloads published to a topic to all subscribers of the topic
+type Broker struct {
+	// BufferSize is a number of payloads buffered for each subscriber, defaults to DefaultBufferSize
+	BufferSize int
+
+	lock   sync.RWMutex
+	topics map[string]map[*Subscription]struct{}
+}
+
+// New returns new broker
+func New() *Broker {
+	return &Broker{
+		topics: make(map[string]map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe returns a subscription receiving payloads published to topic
+func (b *Broker) Subscribe(topic string) *Subscription {
+	size := b.BufferSize
+	if size <= 0 {
+		size = DefaultBufferSize
+	}
+	s := &Subscription{
+		broker: b,
+		topic:  topic,
+		events: make(chan interface{}, size),
+		done:   make(chan struct{}),
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	subs, ok := b.topics[topic]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		b.topics[topic] = subs
+	}
+	subs[s] = struct{}{}
+	return s
+}
+
+// Publish sends payload to subscribers of topic without waiting for them and returns
+// the number of subscribers that received it. Subscribers which buffer is full do not
+// receive the payload.
+func (b *Broker) Publish(topic string, payload interface{}) int {
+	b.lock.RLock()
+	subs := make([]*Subscription, 0, len(b.topics[topic]))
+	for s := range b.topics[topic] {
+		subs = append(subs, s)
+	}
+	b.lock.RUnlock()
+	n := 0
+	for _, s := range subs {
+		select {
+		case s.events <- payload:
+			n++
+		default:
+		}
+	}
+	return n
+}
+
+func (b *Broker) unsubscribe(s *Subscription) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	subs := b.topics[s.topic]
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(b.topics, s.topic)
+	}
+}
+
+// Subscription receives payloads published to a topic
+type Subscription struct {
+	broker *Broker
+	topic  string
+	events chan interface{}
+	done   chan struct{}
+	once   sync.Once
+	value  interface{}
+}
+
+// Next blocks until a payload is published to topic and returns true or until
+// subscription is closed and returns false
+func (s *Subscription) Next() bool {
+	select {
+	case <-s.done:
+		return false
+	default:
+	}
+	select {
+	case v := <-s.events:
+		s.value = v
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+// Read returns payload received by last call to Next
+func (s *Subscription) Read() (interface{}, error) {
+	return s.value, nil
+}
+
+// Error always returns nil, subscription finishes only when it is closed
+func (s *Subscription) Error() error {
+	return nil
+}
+
+// Close removes subscription from broker
+func (s *Subscription) Close() error {
+	s.once.Do(func() {
+		close(s.done)
+		s.broker.unsubscribe(s)
+	})
+	return nil
+}
@@ -0,0 +1,50 @@
+package pubsub_test
+
+import (
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker(t *testing.T) {
+	b := pubsub.New()
+	a := b.Subscribe("room.a")
+	a2 := b.Subscribe("room.a")
+	other := b.Subscribe("room.b")
+	received := make(chan interface{}, 2)
+	for _, s := range []*pubsub.Subscription{a, a2} {
+		go func(s *pubsub.Subscription) {
+			if s.Next() {
+				v, _ := s.Read()
+				received <- v
+			}
+		}(s)
+	}
+	assert.Equal(t, 2, b.Publish("room.a", "hello"))
+	assert.Equal(t, "hello", <-received)
+	assert.Equal(t, "hello", <-received)
+	other.Close()
+	assert.False(t, other.Next())
+	assert.NoError(t, other.Error())
+	assert.Equal(t, 0, b.Publish("room.b", "hello"))
+	a.Close()
+	a2.Close()
+	assert.Equal(t, 0, b.Publish("room.a", "hello"))
+}
+
+func TestBrokerBuffer(t *testing.T) {
+	b := pubsub.New()
+	b.BufferSize = 2
+	slow := b.Subscribe("room")
+	defer slow.Close()
+	assert.Equal(t, 1, b.Publish("room", 1))
+	assert.Equal(t, 1, b.Publish("room", 2))
+	assert.Equal(t, 0, b.Publish("room", 3))
+	for _, expected := range []interface{}{1, 2} {
+		assert.True(t, slow.Next())
+		v, _ := slow.Read()
+		assert.Equal(t, expected, v)
+	}
+	assert.Equal(t, 1, b.Publish("room", 4))
+}
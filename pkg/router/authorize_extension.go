@@ -9,20 +9,21 @@ import (
 
 type authorizeExtension struct {
 	baseExtension
-	authorizeHandler func(*graphql.Params) (bool, error)
+	authorizeHandler func(*graphql.Params) (bool, interface{}, error)
 }
 
 func (a authorizeExtension) Init(ctx context.Context, p *graphql.Params) context.Context {
 	if a.authorizeHandler != nil {
 		rtContext := getRouterContext(ctx)
 		if rtContext.Error == nil {
-			ok, err := a.authorizeHandler(p)
+			ok, claims, err := a.authorizeHandler(p)
 			if err != nil || !ok {
 				if err == nil {
 					err = errors.New("unauthorized")
 				}
 				rtContext.Error = err
 			}
+			rtContext.Claims = claims
 		}
 	}
 	return ctx
@@ -0,0 +1,73 @@
+package router
+
+import (
+	"context"
+	"sync"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+)
+
+// fieldBatch is a list of pending resolutions of the same Type.field
+// that are sent to driver in one call.
+type fieldBatch struct {
+	once   sync.Once
+	inputs []driver.FieldResolveInput
+	out    driver.FieldResolveBatchOutput
+}
+
+// fieldBatcher collects field batches for one request
+type fieldBatcher struct {
+	mu      sync.Mutex
+	pending map[string]*fieldBatch
+}
+
+func getFieldBatcher(ctx context.Context) *fieldBatcher {
+	if ctx == nil {
+		return nil
+	}
+	rtContext, _ := ctx.Value(ContextKey).(*Context)
+	if rtContext == nil {
+		return nil
+	}
+	return &rtContext.batches
+}
+
+// add appends input to pending batch for key returning batch and index
+// of the input in that batch
+func (f *fieldBatcher) add(key string, in driver.FieldResolveInput) (*fieldBatch, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.pending == nil {
+		f.pending = make(map[string]*fieldBatch)
+	}
+	b, ok := f.pending[key]
+	if !ok {
+		b = new(fieldBatch)
+		f.pending[key] = b
+	}
+	b.inputs = append(b.inputs, in)
+	return b, len(b.inputs) - 1
+}
+
+// take removes batch from pending so that no more inputs are added to it
+func (f *fieldBatcher) take(key string, b *fieldBatch) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.pending[key] == b {
+		delete(f.pending, key)
+	}
+}
+
+// resolve sends whole batch to driver on first call and returns output for input at index
+func (f *fieldBatcher) resolve(ctx context.Context, dri driver.Driver, key string, b *fieldBatch, idx int) driver.FieldResolveOutput {
+	b.once.Do(func() {
+		f.take(key, b)
+		b.out = driver.FieldResolveBatch(ctx, dri, driver.FieldResolveBatchInput{
+			Inputs: b.inputs,
+		})
+	})
+	if b.out.Error != nil {
+		return driver.FieldResolveOutput{Error: b.out.Error}
+	}
+	return b.out.Outputs[idx]
+}
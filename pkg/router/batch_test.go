@@ -0,0 +1,83 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouterBatchFieldResolve(t *testing.T) {
+	env := router.Environment{
+		Provider: "batch",
+		Runtime:  "batch",
+	}
+	mockDriver := new(drivertest.MockBatchDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "items"
+	})).Return(driver.FieldResolveOutput{
+		Response: []interface{}{
+			map[string]interface{}{"id": 1},
+			map[string]interface{}{"id": 2},
+			map[string]interface{}{"id": 3},
+		},
+	})
+	mockDriver.On("FieldResolveBatch", mock.MatchedBy(func(in driver.FieldResolveBatchInput) bool {
+		return len(in.Inputs) == 3
+	})).Return(driver.FieldResolveBatchOutput{
+		Outputs: []driver.FieldResolveOutput{
+			{Response: "a"},
+			{Response: "b"},
+			{Error: &driver.Error{Message: "c"}},
+		},
+	})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.items": {Resolve: types.Function{Name: "items"}},
+			"Item.child": {
+				Resolve: types.Function{Name: "child"},
+				Batch:   true,
+			},
+		},
+		Schema: `
+type Item {
+	id: Int
+	child: String
+}
+type Query {
+	items: [Item]
+}
+schema {
+	query: Query
+}
+`,
+	})
+	assert.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: "{ items { id child } }",
+		Context:       context.Background(),
+	})
+	assert.Equal(t, map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"id": 1, "child": "a"},
+			map[string]interface{}{"id": 2, "child": "b"},
+			map[string]interface{}{"id": 3, "child": nil},
+		},
+	}, res.Data)
+	assert.Len(t, res.Errors, 1)
+	mockDriver.AssertNumberOfCalls(t, "FieldResolve", 1)
+	mockDriver.AssertNumberOfCalls(t, "FieldResolveBatch", 1)
+}
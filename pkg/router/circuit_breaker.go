@@ -0,0 +1,266 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+)
+
+const (
+	defaultCircuitFailureThreshold = 5
+	defaultCircuitOpenTimeout      = 30 * time.Second
+	defaultCircuitHalfOpenMaxCalls = 1
+	defaultCircuitSuccessThreshold = 1
+)
+
+// CircuitState is a state of a circuit breaker
+type CircuitState uint8
+
+const (
+	// CircuitClosed passes all calls to function
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails all calls without calling function
+	CircuitOpen
+	// CircuitHalfOpen passes limited number of trial calls to function
+	CircuitHalfOpen
+)
+
+// String implements fmt.Stringer
+func (c CircuitState) String() string {
+	switch c {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// MarshalJSON implements json.Marshaler
+func (c CircuitState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// CircuitKey identifies a circuit breaker
+type CircuitKey struct {
+	Provider string `json:"provider"`
+	Runtime  string `json:"runtime"`
+	Function string `json:"function"`
+}
+
+// CircuitBreakerState is a snapshot of a circuit breaker state
+type CircuitBreakerState struct {
+	CircuitKey
+	State    CircuitState `json:"state"`
+	Failures int          `json:"failures"`
+	OpenedAt time.Time    `json:"openedAt"`
+}
+
+type circuitBreaker struct {
+	mu        sync.Mutex
+	state     CircuitState
+	failures  int
+	successes int
+	inFlight  int
+	openedAt  time.Time
+}
+
+func (b *circuitBreaker) open() {
+	b.state = CircuitOpen
+	b.openedAt = time.Now()
+	b.successes = 0
+	b.inFlight = 0
+}
+
+// allow returns true if call can be made and whether call is a trial call
+func (b *circuitBreaker) allow(cfg CircuitBreakerConfig) (allowed bool, trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.openedAt) < time.Duration(cfg.OpenTimeout) {
+			return false, false
+		}
+		b.state = CircuitHalfOpen
+		fallthrough
+	case CircuitHalfOpen:
+		if b.inFlight >= cfg.HalfOpenMaxCalls {
+			return false, false
+		}
+		b.inFlight++
+		return true, true
+	}
+	return true, false
+}
+
+// done records result of a call
+func (b *circuitBreaker) done(cfg CircuitBreakerConfig, trial, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch {
+	case trial && b.state == CircuitHalfOpen:
+		b.inFlight--
+		if failed {
+			b.open()
+			return
+		}
+		b.successes++
+		if b.successes >= cfg.SuccessThreshold {
+			b.state = CircuitClosed
+			b.failures = 0
+			b.inFlight = 0
+		}
+	case !trial && b.state == CircuitClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= cfg.FailureThreshold {
+			b.open()
+		}
+	}
+}
+
+// release ends a call without recording its result, used when request was
+// canceled or timed out before function could finish
+func (b *circuitBreaker) release(trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if trial && b.state == CircuitHalfOpen {
+		b.inFlight--
+	}
+}
+
+// CircuitBreakers keeps circuit breakers for all functions called by router
+type CircuitBreakers struct {
+	config   CircuitBreakerConfig
+	mu       sync.Mutex
+	breakers map[CircuitKey]*circuitBreaker
+}
+
+// NewCircuitBreakers creates circuit breakers with config
+func NewCircuitBreakers(cfg CircuitBreakerConfig) *CircuitBreakers {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = defaultCircuitFailureThreshold
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = Duration(defaultCircuitOpenTimeout)
+	}
+	if cfg.HalfOpenMaxCalls <= 0 {
+		cfg.HalfOpenMaxCalls = defaultCircuitHalfOpenMaxCalls
+	}
+	if cfg.SuccessThreshold <= 0 {
+		cfg.SuccessThreshold = defaultCircuitSuccessThreshold
+	}
+	return &CircuitBreakers{
+		config:   cfg,
+		breakers: make(map[CircuitKey]*circuitBreaker),
+	}
+}
+
+func (c *CircuitBreakers) get(key CircuitKey) *circuitBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.breakers[key]
+	if !ok {
+		b = new(circuitBreaker)
+		c.breakers[key] = b
+	}
+	return b
+}
+
+// States returns state of all circuit breakers that were used
+func (c *CircuitBreakers) States() []CircuitBreakerState {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	states := make([]CircuitBreakerState, 0, len(c.breakers))
+	for k, b := range c.breakers {
+		b.mu.Lock()
+		state := CircuitBreakerState{
+			CircuitKey: k,
+			State:      b.state,
+			Failures:   b.failures,
+		}
+		if b.state != CircuitClosed {
+			state.OpenedAt = b.openedAt
+		}
+		b.mu.Unlock()
+		states = append(states, state)
+	}
+	c.mu.Unlock()
+	sort.Slice(states, func(i, j int) bool {
+		a, b := states[i].CircuitKey, states[j].CircuitKey
+		if a.Provider != b.Provider {
+			return a.Provider < b.Provider
+		}
+		if a.Runtime != b.Runtime {
+			return a.Runtime < b.Runtime
+		}
+		return a.Function < b.Function
+	})
+	return states
+}
+
+// State returns state of circuit breaker for a function
+func (c *CircuitBreakers) State(key CircuitKey) CircuitState {
+	if c == nil {
+		return CircuitClosed
+	}
+	c.mu.Lock()
+	b, ok := c.breakers[key]
+	c.mu.Unlock()
+	if !ok {
+		return CircuitClosed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// isCircuitFailure returns true if error means that function is unhealthy
+func isCircuitFailure(err *driver.Error) bool {
+	return err != nil && (err.Code == driver.ErrorCodeUnavailable || err.Code == driver.ErrorCodeTimeout)
+}
+
+// wrap returns a driver with calls guarded by circuit breakers
+func (c *CircuitBreakers) wrap(dri driver.Driver, env *Environment) driver.Driver {
+	if c == nil {
+		return dri
+	}
+	var provider, runtime string
+	if env != nil {
+		provider, runtime = env.Provider, env.Runtime
+	}
+	return wrapDriver(dri, func(ctx context.Context, fn types.Function, call callFunc) (interface{}, *driver.Error) {
+		b := c.get(CircuitKey{
+			Provider: provider,
+			Runtime:  runtime,
+			Function: fn.Name,
+		})
+		allowed, trial := b.allow(c.config)
+		if !allowed {
+			return nil, &driver.Error{
+				Message: fmt.Sprintf("function %s is unavailable, circuit breaker is open", fn.Name),
+				Code:    driver.ErrorCodeCircuitOpen,
+			}
+		}
+		v, err := call(ctx)
+		if ctx.Err() != nil {
+			b.release(trial)
+			return v, err
+		}
+		b.done(c.config, trial, isCircuitFailure(err))
+		return v, err
+	})
+}
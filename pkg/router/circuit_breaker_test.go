@@ -0,0 +1,138 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	env := router.Environment{
+		Provider: "circuit",
+		Runtime:  "breaker",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{
+		Error: &driver.Error{Message: "unavailable", Code: driver.ErrorCodeUnavailable},
+	}).Twice()
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Response: "data"})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.field": {Resolve: types.Function{Name: "field"}},
+		},
+		Schema: `
+type Query {
+	field: String
+}
+schema {
+	query: Query
+}
+`,
+		CircuitBreaker: &router.CircuitBreakerConfig{
+			FailureThreshold: 2,
+			OpenTimeout:      router.Duration(20 * time.Millisecond),
+		},
+	})
+	assert.NoError(t, err)
+	key := router.CircuitKey{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+		Function: "field",
+	}
+	do := func() *graphql.Result {
+		return graphql.Do(graphql.Params{
+			Schema:        rt.Schema,
+			RequestString: "{ field }",
+			Context:       context.Background(),
+		})
+	}
+	do()
+	assert.Equal(t, router.CircuitClosed, rt.CircuitBreakers.State(key))
+	do()
+	assert.Equal(t, router.CircuitOpen, rt.CircuitBreakers.State(key))
+	res := do()
+	if assert.Len(t, res.Errors, 1) {
+		assert.Contains(t, res.Errors[0].Message, "circuit breaker is open")
+	}
+	mockDriver.AssertNumberOfCalls(t, "FieldResolve", 2)
+	time.Sleep(30 * time.Millisecond)
+	res = do()
+	assert.Equal(t, map[string]interface{}{"field": "data"}, res.Data)
+	assert.Equal(t, router.CircuitClosed, rt.CircuitBreakers.State(key))
+	states := rt.CircuitBreakers.States()
+	if assert.Len(t, states, 1) {
+		assert.Equal(t, key, states[0].CircuitKey)
+	}
+}
+
+func TestCircuitBreakerIgnoresCanceledCalls(t *testing.T) {
+	env := router.Environment{
+		Provider: "circuit",
+		Runtime:  "canceled",
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.Anything).Run(func(mock.Arguments) {
+		cancel()
+	}).Return(driver.FieldResolveOutput{
+		Error: &driver.Error{Message: "unavailable", Code: driver.ErrorCodeUnavailable},
+	}).Once()
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{
+		Error: &driver.Error{Message: "unavailable", Code: driver.ErrorCodeUnavailable},
+	})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.field": {Resolve: types.Function{Name: "field"}},
+		},
+		Schema: `
+type Query {
+	field: String
+}
+schema {
+	query: Query
+}
+`,
+		CircuitBreaker: &router.CircuitBreakerConfig{
+			FailureThreshold: 1,
+		},
+	})
+	assert.NoError(t, err)
+	key := router.CircuitKey{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+		Function: "field",
+	}
+	graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: "{ field }",
+		Context:       ctx,
+	})
+	mockDriver.AssertNumberOfCalls(t, "FieldResolve", 1)
+	assert.Equal(t, router.CircuitClosed, rt.CircuitBreakers.State(key))
+	graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: "{ field }",
+		Context:       context.Background(),
+	})
+	assert.Equal(t, router.CircuitOpen, rt.CircuitBreakers.State(key))
+}
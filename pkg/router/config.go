@@ -7,7 +7,9 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/graphql-editor/stucco/pkg/pubsub"
 	"github.com/graphql-editor/stucco/pkg/types"
 )
 
@@ -59,6 +61,98 @@ func newEnvironment(base *Environment, defaultEnv Environment) *Environment {
 	return dst
 }
 
+// Duration is a time.Duration read from config either as a number of seconds
+// or as a string accepted by time.ParseDuration
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch vv := v.(type) {
+	case float64:
+		*d = Duration(vv * float64(time.Second))
+	case string:
+		td, err := time.ParseDuration(vv)
+		if err != nil {
+			return err
+		}
+		*d = Duration(td)
+	default:
+		return errors.New("duration must be a number of seconds or a duration string")
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// RetryConfig defines how failed function calls are retried
+type RetryConfig struct {
+	// MaxAttempts is a maximum number of calls, including first one
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+	// RetryOn is a list of error classes that are retried: timeout, unavailable or error.
+	// Defaults to timeout and unavailable.
+	RetryOn []string `json:"retryOn,omitempty"`
+	// InitialInterval is a wait time before first retry, defaults to 100ms
+	InitialInterval Duration `json:"initialInterval,omitempty"`
+	// MaxInterval caps wait time between retries, defaults to 10s
+	MaxInterval Duration `json:"maxInterval,omitempty"`
+	// Multiplier increases interval after each retry, defaults to 2
+	Multiplier float64 `json:"multiplier,omitempty"`
+	// Jitter is a fraction of interval that is randomly removed from it, between 0 and 1
+	Jitter float64 `json:"jitter,omitempty"`
+}
+
+// PolicyConfig defines timeout and retry policy of function calls
+type PolicyConfig struct {
+	// Timeout of a single function call
+	Timeout Duration     `json:"timeout,omitempty"`
+	Retry   *RetryConfig `json:"retry,omitempty"`
+}
+
+// CircuitBreakerConfig configures circuit breakers guarding function calls.
+// Circuit breaker opens after consecutive calls fail because function was
+// unavailable or timed out.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is a number of consecutive failures that opens circuit, defaults to 5
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+	// OpenTimeout is a time for which circuit stays open before trial calls are allowed, defaults to 30s
+	OpenTimeout Duration `json:"openTimeout,omitempty"`
+	// HalfOpenMaxCalls is a number of concurrent trial calls in half-open state, defaults to 1
+	HalfOpenMaxCalls int `json:"halfOpenMaxCalls,omitempty"`
+	// SuccessThreshold is a number of successful trial calls that close circuit, defaults to 1
+	SuccessThreshold int `json:"successThreshold,omitempty"`
+}
+
+// CostConfig configures static analysis of operation cost done before execution.
+// Cost of a field is taken from resolver config, @cost(weight: Int, multipliers: [String!])
+// directive on field or on returned type or defaults. Cost of a list field is
+// multiplied by value of its list size argument.
+type CostConfig struct {
+	// MaxCost is a maximum allowed cost of operation, 0 means no limit
+	MaxCost int `json:"maxCost,omitempty"`
+	// MaxDepth is a maximum allowed depth of operation selections, 0 means no limit
+	MaxDepth int `json:"maxDepth,omitempty"`
+	// MaxAliases is a maximum number of aliased fields in operation, 0 means no limit
+	MaxAliases int `json:"maxAliases,omitempty"`
+	// MaxRootFields is a maximum number of root fields in operation, 0 means no limit
+	MaxRootFields int `json:"maxRootFields,omitempty"`
+	// DefaultCost is a cost of field returning object, interface or union, defaults to 1
+	DefaultCost int `json:"defaultCost,omitempty"`
+	// ScalarCost is a cost of field returning scalar or enum, defaults to 0
+	ScalarCost int `json:"scalarCost,omitempty"`
+	// ListSizeArguments are names of arguments limiting list size, defaults to first, last and limit
+	ListSizeArguments []string `json:"listSizeArguments,omitempty"`
+	// DefaultListSize is used as a multiplier for list fields without size argument, defaults to 1
+	DefaultListSize int `json:"defaultListSize,omitempty"`
+}
+
+// WebhookConfig defines webhook for a function
 type WebhookConfig struct {
 	Pattern      string            `json:"pattern,omitempty"`
 	BodyBindings map[string]string `json:"bodyBindings,omitempty"`
@@ -70,6 +164,38 @@ type ResolverConfig struct {
 	Resolve     types.Function `json:"resolve"`
 	Skip        bool           `json:"skip"`
 	Webhook     *WebhookConfig `json:"webhook,omitempty"`
+	Policy      *PolicyConfig  `json:"policy,omitempty"`
+	// Cost of field used in static cost analysis, overrides @cost directive
+	Cost *int `json:"cost,omitempty"`
+	// Batch collects resolutions of this field within one execution
+	// step and sends them to driver in one call
+	Batch bool `json:"batch,omitempty"`
+	// Authorize is called before field is resolved, field is resolved to null
+	// with an error if it returns false. Config with key Type.* sets authorize
+	// function for all fields of a type that do not have their own.
+	Authorize *types.Function `json:"authorize,omitempty"`
+	// Value is a constant value returned by field without calling a function
+	Value interface{} `json:"value,omitempty"`
+	// Path selects value returned by field from field source, for example
+	// data.items[0].name. Takes precedence over Value.
+	Path string `json:"path,omitempty"`
+	// Arguments maps keys of an object returned by field to paths of values
+	// in field arguments. Keys are added to object from Path or Value.
+	Arguments map[string]string `json:"arguments,omitempty"`
+	// Function describes a function resolving field for providers which functions
+	// are defined by config, for example an endpoint called by http or graphql provider.
+	// Function is added to driver of environment under field name unless Resolve.Name is set.
+	Function interface{} `json:"function,omitempty"`
+	// Publish publishes value resolved by field to a topic of pub/sub broker.
+	// Field without a resolver resolves to its arguments.
+	Publish *PublishConfig `json:"publish,omitempty"`
+}
+
+// PublishConfig defines a topic to which value resolved by field is published
+type PublishConfig struct {
+	// Topic is a template of topic name with arguments and source of field
+	// interpolated, for example messages.{args.room}
+	Topic string `json:"topic"`
 }
 
 // ScalarConfig defines parse and serialize function configurations for scalar
@@ -77,6 +203,7 @@ type ScalarConfig struct {
 	Environment *Environment   `json:"environment,omitempty"`
 	Parse       types.Function `json:"parse"`
 	Serialize   types.Function `json:"serialize"`
+	Policy      *PolicyConfig  `json:"policy,omitempty"`
 }
 
 // InterfaceConfig defines function configuration for interface type resolution
@@ -84,12 +211,14 @@ type InterfaceConfig struct {
 	Environment *Environment             `json:"environment,omitempty"`
 	ResolveType types.Function           `json:"resolveType"`
 	Webhooks    map[string]WebhookConfig `json:"webhooks,omitempty"`
+	Policy      *PolicyConfig            `json:"policy,omitempty"`
 }
 
 // UnionConfig defines function configuration for union type resolution
 type UnionConfig struct {
 	Environment *Environment   `json:"environment,omitempty"`
 	ResolveType types.Function `json:"resolveType"`
+	Policy      *PolicyConfig  `json:"policy,omitempty"`
 }
 
 // SecretsConfig defines a secret configuration for router
@@ -101,6 +230,7 @@ type SecretsConfig struct {
 type AuthorizeConfig struct {
 	Environment *Environment   `json:"environment,omitempty"`
 	Authorize   types.Function `json:"authorize"`
+	Policy      *PolicyConfig  `json:"policy,omitempty"`
 }
 
 // Config is a router configuration mapping defined endpoints with thier runtime config
@@ -117,6 +247,36 @@ type Config struct {
 	MaxDepth            int                           `json:"maxDepth,omitempty"`
 	Authorize           *AuthorizeConfig              `json:"authorize,omitempty"` // Authorize configures optional authorization function before any resolver is ran
 	RequestTimeout      int64                         `json:"requestTimeout,omitempty"`
+	CircuitBreaker      *CircuitBreakerConfig         `json:"circuitBreaker,omitempty"` // CircuitBreaker enables circuit breakers per environment and function
+	Cost                *CostConfig                   `json:"cost,omitempty"`           // Cost enables static operation cost analysis
+	Mock                *MockConfig                   `json:"mock,omitempty"`           // Mock configures values returned by mock provider
+	Federation          *FederationConfig             `json:"federation,omitempty"`     // Federation enables Apollo Federation subgraph support
+	Broker              *pubsub.Broker                `json:"-" yaml:"-"`               // Broker of published payloads shared with publishers, router creates its own if nil
+}
+
+// EntityConfig defines reference resolver function of federated entity
+type EntityConfig struct {
+	Environment      *Environment   `json:"environment,omitempty"`
+	ResolveReference types.Function `json:"resolveReference"`
+	Policy           *PolicyConfig  `json:"policy,omitempty"`
+}
+
+// FederationConfig enables Apollo Federation subgraph fields _service and _entities.
+// Types with @key directive are entities, representations of entities without
+// a reference resolver are returned as is.
+type FederationConfig struct {
+	// Entities is a map of reference resolvers of entities keyed by type name
+	Entities map[string]EntityConfig `json:"entities,omitempty"`
+}
+
+// MockConfig configures mock provider which synthesizes values of fields from schema
+type MockConfig struct {
+	// Fixtures is a path to a file with values of fields keyed by Type.field
+	Fixtures string `json:"fixtures,omitempty"`
+	// Seed of generated values
+	Seed int64 `json:"seed,omitempty"`
+	// Missing resolves fields without resolver config with mock provider
+	Missing bool `json:"missing,omitempty"`
 }
 
 // AddResolver creates a new resolver mapping in config
@@ -201,7 +361,8 @@ func isFile(s string) bool {
 	return err == nil && st != nil
 }
 
-func (c Config) rawSchema() (string, error) {
+// RawSchema returns schema source from config, environment, file or url
+func (c Config) RawSchema() (string, error) {
 	if env := os.Getenv(SchemaEnv); c.Schema == "" && env != "" {
 		c.Schema = env
 	}
@@ -256,4 +417,10 @@ type SubscriptionConfig struct {
 	Kind             SubscriptionKind `json:"kind,omitempty"`
 	CreateConnection types.Function   `json:"createConnection,omitempty"`
 	Listen           types.Function   `json:"listen,omitempty"`
+	// Redirect is called with subscription connection input and returns an address
+	// of subscription service to which client is redirected
+	Redirect types.Function `json:"redirect,omitempty"`
+	// Topic of pub/sub broker subscription listens on instead of calling listen
+	// function, arguments of field are interpolated, for example messages.{args.room}
+	Topic string `json:"topic,omitempty"`
 }
@@ -0,0 +1,456 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-editor/stucco/pkg/utils"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+const costDirectiveName = "cost"
+
+// CostLimitExceeded is a code of error returned when operation exceeds
+// one of configured cost limits
+const CostLimitExceeded = "COST_LIMIT_EXCEEDED"
+
+var defaultListSizeArguments = []string{"first", "last", "limit"}
+
+// fieldCost is a cost defined in config or through directive
+type fieldCost struct {
+	weight      *int
+	multipliers []string
+}
+
+type costContextKeyType int
+
+const costContextKey costContextKeyType = 0
+
+// CostResult is a result of operation cost analysis returned in
+// response extensions
+type CostResult struct {
+	RequestedQueryCost int `json:"requestedQueryCost"`
+	MaximumAvailable   int `json:"maximumAvailable,omitempty"`
+	Depth              int `json:"depth"`
+}
+
+type costContext struct {
+	params *graphql.Params
+	result *CostResult
+	err    *costLimitError
+}
+
+// costLimitError rejects operation before its execution
+type costLimitError struct {
+	message    string
+	extensions map[string]interface{}
+}
+
+func (c *costLimitError) Error() string {
+	return c.message
+}
+
+// Extensions implements gqlerrors.ExtendedError
+func (c *costLimitError) Extensions() map[string]interface{} {
+	return c.extensions
+}
+
+func newCostLimitError(message string, extensions map[string]interface{}) *costLimitError {
+	extensions["code"] = CostLimitExceeded
+	return &costLimitError{
+		message:    message,
+		extensions: extensions,
+	}
+}
+
+// costExtension rejects operations exceeding configured limits
+// before they are executed
+type costExtension struct {
+	baseExtension
+	config     CostConfig
+	schema     *graphql.Schema
+	fieldCosts map[string]fieldCost
+	typeCosts  map[string]fieldCost
+}
+
+func intArgument(args []*ast.Argument, name string) *int {
+	for _, arg := range args {
+		if arg.Name.Value != name {
+			continue
+		}
+		if v, ok := arg.Value.(*ast.IntValue); ok {
+			if i, err := strconv.Atoi(v.Value); err == nil {
+				return &i
+			}
+		}
+	}
+	return nil
+}
+
+func stringListArgument(args []*ast.Argument, name string) (l []string) {
+	for _, arg := range args {
+		if arg.Name.Value != name {
+			continue
+		}
+		switch v := arg.Value.(type) {
+		case *ast.ListValue:
+			for _, vv := range v.Values {
+				if s, ok := vv.(*ast.StringValue); ok {
+					l = append(l, s.Value)
+				}
+			}
+		case *ast.StringValue:
+			l = append(l, v.Value)
+		}
+	}
+	return
+}
+
+func costDirective(dirs []*ast.Directive) (fc fieldCost, ok bool) {
+	for _, dir := range dirs {
+		if dir.Name.Value == costDirectiveName {
+			return fieldCost{
+				weight:      intArgument(dir.Arguments, "weight"),
+				multipliers: stringListArgument(dir.Arguments, "multipliers"),
+			}, true
+		}
+	}
+	return
+}
+
+// schemaCosts collects costs defined with @cost directive in schema
+func schemaCosts(doc *ast.Document) (fieldCosts map[string]fieldCost, typeCosts map[string]fieldCost) {
+	fieldCosts = make(map[string]fieldCost)
+	typeCosts = make(map[string]fieldCost)
+	if doc == nil {
+		return
+	}
+	addFields := func(tn string, fields []*ast.FieldDefinition) {
+		for _, f := range fields {
+			if fc, ok := costDirective(f.Directives); ok {
+				fieldCosts[utils.FieldName(tn, f.Name.Value)] = fc
+			}
+		}
+	}
+	for _, def := range doc.Definitions {
+		switch v := def.(type) {
+		case *ast.ObjectDefinition:
+			if fc, ok := costDirective(v.Directives); ok {
+				typeCosts[v.Name.Value] = fc
+			}
+			addFields(v.Name.Value, v.Fields)
+		case *ast.InterfaceDefinition:
+			if fc, ok := costDirective(v.Directives); ok {
+				typeCosts[v.Name.Value] = fc
+			}
+			addFields(v.Name.Value, v.Fields)
+		}
+	}
+	return
+}
+
+func newCostExtension(cfg CostConfig, schema *graphql.Schema, doc *ast.Document, resolvers map[string]ResolverConfig) costExtension {
+	if cfg.DefaultCost <= 0 {
+		cfg.DefaultCost = 1
+	}
+	if cfg.DefaultListSize <= 0 {
+		cfg.DefaultListSize = 1
+	}
+	if len(cfg.ListSizeArguments) == 0 {
+		cfg.ListSizeArguments = defaultListSizeArguments
+	}
+	fieldCosts, typeCosts := schemaCosts(doc)
+	for k, rs := range resolvers {
+		if rs.Cost != nil {
+			fc := fieldCosts[k]
+			fc.weight = rs.Cost
+			fieldCosts[k] = fc
+		}
+	}
+	return costExtension{
+		config:     cfg,
+		schema:     schema,
+		fieldCosts: fieldCosts,
+		typeCosts:  typeCosts,
+	}
+}
+
+// Name implements graphql.Extension
+func (c costExtension) Name() string { return "cost" }
+
+// Init implements graphql.Extension
+func (c costExtension) Init(ctx context.Context, p *graphql.Params) context.Context {
+	return context.WithValue(ctx, costContextKey, &costContext{params: p})
+}
+
+// ValidationDidStart implements graphql.Extension. Operation exceeding limits is
+// rejected before execution starts.
+func (c costExtension) ValidationDidStart(ctx context.Context) (context.Context, graphql.ValidationFinishFunc) {
+	return ctx, func(errs []gqlerrors.FormattedError) {
+		costCtx, ok := ctx.Value(costContextKey).(*costContext)
+		if len(errs) != 0 || !ok {
+			return
+		}
+		costCtx.result, costCtx.err = c.analyze(costCtx.params)
+		if costCtx.err != nil {
+			abortExecution(ctx, costCtx.err)
+		}
+	}
+}
+
+// HasResult implements graphql.Extension
+func (c costExtension) HasResult(ctx context.Context) bool {
+	costCtx, ok := ctx.Value(costContextKey).(*costContext)
+	return ok && costCtx.result != nil
+}
+
+// GetResult implements graphql.Extension
+func (c costExtension) GetResult(ctx context.Context) interface{} {
+	return ctx.Value(costContextKey).(*costContext).result
+}
+
+func (c costExtension) analyze(p *graphql.Params) (*CostResult, *costLimitError) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(p.RequestString),
+			Name: "GraphQL request",
+		}),
+	})
+	// document was already validated, so it cannot fail here
+	if err != nil {
+		return nil, nil
+	}
+	var op *ast.OperationDefinition
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		switch v := def.(type) {
+		case *ast.OperationDefinition:
+			if p.OperationName == "" || (v.Name != nil && v.Name.Value == p.OperationName) {
+				op = v
+			}
+		case *ast.FragmentDefinition:
+			fragments[v.Name.Value] = v
+		}
+	}
+	if op == nil {
+		return nil, nil
+	}
+	var root graphql.Type
+	switch op.Operation {
+	case ast.OperationTypeQuery:
+		root = c.schema.QueryType()
+	case ast.OperationTypeMutation:
+		root = c.schema.MutationType()
+	case ast.OperationTypeSubscription:
+		root = c.schema.SubscriptionType()
+	}
+	a := costAnalysis{
+		costExtension: c,
+		fragments:     fragments,
+		variables:     variableValues(op, p.VariableValues),
+	}
+	cost, depth := a.selectionSetCost(root, op.SelectionSet, 1)
+	result := &CostResult{
+		RequestedQueryCost: cost,
+		MaximumAvailable:   c.config.MaxCost,
+		Depth:              depth,
+	}
+	if c.config.MaxRootFields > 0 {
+		if rootFields := a.countFields(op.SelectionSet); rootFields > c.config.MaxRootFields {
+			return result, newCostLimitError(
+				fmt.Sprintf("operation has %d root fields, maximum is %d", rootFields, c.config.MaxRootFields),
+				map[string]interface{}{"cost": cost, "rootFields": rootFields, "maxRootFields": c.config.MaxRootFields},
+			)
+		}
+	}
+	if c.config.MaxAliases > 0 && a.aliases > c.config.MaxAliases {
+		return result, newCostLimitError(
+			fmt.Sprintf("operation has %d aliases, maximum is %d", a.aliases, c.config.MaxAliases),
+			map[string]interface{}{"cost": cost, "aliases": a.aliases, "maxAliases": c.config.MaxAliases},
+		)
+	}
+	if c.config.MaxDepth > 0 && depth > c.config.MaxDepth {
+		return result, newCostLimitError(
+			fmt.Sprintf("operation depth %d exceeds maximum depth %d", depth, c.config.MaxDepth),
+			map[string]interface{}{"cost": cost, "depth": depth, "maxDepth": c.config.MaxDepth},
+		)
+	}
+	if c.config.MaxCost > 0 && cost > c.config.MaxCost {
+		return result, newCostLimitError(
+			fmt.Sprintf("operation cost %d exceeds maximum cost %d", cost, c.config.MaxCost),
+			map[string]interface{}{"cost": cost, "maxCost": c.config.MaxCost},
+		)
+	}
+	return result, nil
+}
+
+func variableValues(op *ast.OperationDefinition, values map[string]interface{}) map[string]interface{} {
+	variables := make(map[string]interface{}, len(values))
+	for _, def := range op.VariableDefinitions {
+		if def.DefaultValue != nil {
+			variables[def.Variable.Name.Value] = def.DefaultValue.GetValue()
+		}
+	}
+	for k, v := range values {
+		variables[k] = v
+	}
+	return variables
+}
+
+type costAnalysis struct {
+	costExtension
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	aliases   int
+}
+
+func (a *costAnalysis) intValue(v ast.Value) (int, bool) {
+	var raw interface{}
+	switch vv := v.(type) {
+	case *ast.Variable:
+		raw = a.variables[vv.Name.Value]
+	case *ast.IntValue:
+		raw = vv.Value
+	}
+	switch i := raw.(type) {
+	case int:
+		return i, true
+	case int64:
+		return int(i), true
+	case float64:
+		return int(i), true
+	case string:
+		n, err := strconv.Atoi(i)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// listSize returns a multiplier for list field based on its arguments
+func (a *costAnalysis) listSize(field *ast.Field, multipliers []string) int {
+	if len(multipliers) == 0 {
+		multipliers = a.config.ListSizeArguments
+	}
+	for _, name := range multipliers {
+		for _, arg := range field.Arguments {
+			if arg.Name.Value != name {
+				continue
+			}
+			if size, ok := a.intValue(arg.Value); ok && size >= 0 {
+				return size
+			}
+		}
+	}
+	return a.config.DefaultListSize
+}
+
+func isListType(t graphql.Type) bool {
+	if nn, ok := t.(*graphql.NonNull); ok {
+		t = nn.OfType
+	}
+	_, ok := t.(*graphql.List)
+	return ok
+}
+
+func fieldDefinition(parent graphql.Type, name string) *graphql.FieldDefinition {
+	switch t := parent.(type) {
+	case *graphql.Object:
+		return t.Fields()[name]
+	case *graphql.Interface:
+		return t.Fields()[name]
+	}
+	return nil
+}
+
+func (a *costAnalysis) fieldCost(parent graphql.Type, field *ast.Field, depth int) (int, int) {
+	name := field.Name.Value
+	def := fieldDefinition(parent, name)
+	if def == nil {
+		return 0, depth
+	}
+	named, _ := graphql.GetNamed(def.Type).(graphql.Type)
+	if named == nil {
+		return 0, depth
+	}
+	fc, ok := a.fieldCosts[utils.FieldName(parent.Name(), name)]
+	if !ok || fc.weight == nil {
+		if tc, ok := a.typeCosts[named.Name()]; ok && tc.weight != nil {
+			fc.weight = tc.weight
+		}
+	}
+	var weight int
+	switch {
+	case fc.weight != nil:
+		weight = *fc.weight
+	case graphql.IsLeafType(named):
+		weight = a.config.ScalarCost
+	default:
+		weight = a.config.DefaultCost
+	}
+	childCost, childDepth := a.selectionSetCost(named, field.SelectionSet, depth+1)
+	if field.SelectionSet == nil {
+		childDepth = depth
+	}
+	cost := weight + childCost
+	if isListType(def.Type) || len(fc.multipliers) > 0 {
+		cost *= a.listSize(field, fc.multipliers)
+	}
+	return cost, childDepth
+}
+
+func (a *costAnalysis) selectionSetCost(parent graphql.Type, set *ast.SelectionSet, depth int) (cost int, maxDepth int) {
+	maxDepth = depth
+	if set == nil || parent == nil {
+		return
+	}
+	for _, sel := range set.Selections {
+		var c, d int
+		switch v := sel.(type) {
+		case *ast.Field:
+			if v.Alias != nil && v.Alias.Value != v.Name.Value {
+				a.aliases++
+			}
+			c, d = a.fieldCost(parent, v, depth)
+		case *ast.InlineFragment:
+			t := parent
+			if v.TypeCondition != nil {
+				t = a.schema.Type(v.TypeCondition.Name.Value)
+			}
+			c, d = a.selectionSetCost(t, v.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			if frag, ok := a.fragments[v.Name.Value]; ok {
+				c, d = a.selectionSetCost(a.schema.Type(frag.TypeCondition.Name.Value), frag.SelectionSet, depth)
+			}
+		}
+		cost += c
+		if d > maxDepth {
+			maxDepth = d
+		}
+	}
+	return
+}
+
+// countFields counts fields in selection set including fields from fragments
+func (a *costAnalysis) countFields(set *ast.SelectionSet) (n int) {
+	if set == nil {
+		return
+	}
+	for _, sel := range set.Selections {
+		switch v := sel.(type) {
+		case *ast.Field:
+			n++
+		case *ast.InlineFragment:
+			n += a.countFields(v.SelectionSet)
+		case *ast.FragmentSpread:
+			if frag, ok := a.fragments[v.Name.Value]; ok {
+				n += a.countFields(frag.SelectionSet)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,226 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/pubsub"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const costSchema = `
+directive @cost(weight: Int, multipliers: [String!]) on FIELD_DEFINITION | OBJECT
+
+type Author {
+	name: String
+}
+
+type Book @cost(weight: 2) {
+	title: String
+	author: Author
+	similar(count: Int): [Book] @cost(weight: 3, multipliers: ["count"])
+}
+
+type Query {
+	book: Book
+	books(first: Int): [Book]
+	version: String
+	expensive: String
+}
+
+schema {
+	query: Query
+}
+`
+
+func TestCostExtension(t *testing.T) {
+	env := router.Environment{
+		Provider: "cost",
+		Runtime:  "analysis",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Response: "data"})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	cost := func(i int) *int { return &i }
+	data := []struct {
+		title         string
+		config        router.CostConfig
+		query         string
+		variables     map[string]interface{}
+		expectedCost  int
+		expectedError string
+		expectedExt   map[string]interface{}
+	}{
+		{
+			title:        "ReportsCost",
+			config:       router.CostConfig{MaxCost: 100},
+			query:        `{ book { title author { name } } version }`,
+			expectedCost: 3,
+		},
+		{
+			title:        "MultipliesListByArgument",
+			config:       router.CostConfig{MaxCost: 100},
+			query:        `{ books(first: 10) { title author { name } } }`,
+			expectedCost: 30,
+		},
+		{
+			title:        "MultipliesListByVariable",
+			config:       router.CostConfig{MaxCost: 100},
+			query:        `query Books($n: Int) { books(first: $n) { title } }`,
+			variables:    map[string]interface{}{"n": 5},
+			expectedCost: 10,
+		},
+		{
+			title:        "UsesDirectiveMultipliers",
+			config:       router.CostConfig{MaxCost: 100},
+			query:        `{ book { similar(count: 4) { title } } }`,
+			expectedCost: 14,
+		},
+		{
+			title:        "FollowsFragments",
+			config:       router.CostConfig{MaxCost: 100},
+			query:        `{ book { ...F } } fragment F on Book { author { name } }`,
+			expectedCost: 3,
+		},
+		{
+			title:        "UsesResolverCost",
+			config:       router.CostConfig{MaxCost: 100},
+			query:        `{ expensive }`,
+			expectedCost: 50,
+		},
+		{
+			title:         "RejectsCost",
+			config:        router.CostConfig{MaxCost: 20},
+			query:         `{ books(first: 10) { title author { name } } }`,
+			expectedError: "operation cost 30 exceeds maximum cost 20",
+			expectedExt:   map[string]interface{}{"code": router.CostLimitExceeded, "cost": 30, "maxCost": 20},
+		},
+		{
+			title:         "RejectsDepth",
+			config:        router.CostConfig{MaxDepth: 2},
+			query:         `{ book { author { name } } }`,
+			expectedError: "operation depth 3 exceeds maximum depth 2",
+			expectedExt:   map[string]interface{}{"code": router.CostLimitExceeded, "cost": 3, "depth": 3, "maxDepth": 2},
+		},
+		{
+			title:         "RejectsAliases",
+			config:        router.CostConfig{MaxAliases: 1},
+			query:         `{ a: version b: version }`,
+			expectedError: "operation has 2 aliases, maximum is 1",
+			expectedExt:   map[string]interface{}{"code": router.CostLimitExceeded, "cost": 0, "aliases": 2, "maxAliases": 1},
+		},
+		{
+			title:         "RejectsRootFields",
+			config:        router.CostConfig{MaxRootFields: 2},
+			query:         `{ version book { title } expensive }`,
+			expectedError: "operation has 3 root fields, maximum is 2",
+			expectedExt:   map[string]interface{}{"code": router.CostLimitExceeded, "cost": 52, "rootFields": 3, "maxRootFields": 2},
+		},
+	}
+	for i := range data {
+		tt := data[i]
+		t.Run(tt.title, func(t *testing.T) {
+			rt, err := router.NewRouter(router.Config{
+				Environment: env,
+				Resolvers: map[string]router.ResolverConfig{
+					"Query.expensive": {
+						Resolve: types.Function{Name: "expensive"},
+						Cost:    cost(50),
+					},
+				},
+				Cost:   &tt.config,
+				Schema: costSchema,
+			})
+			require.NoError(t, err)
+			calls := len(mockDriver.Calls)
+			res := graphql.Do(graphql.Params{
+				Schema:         rt.Schema,
+				RequestString:  tt.query,
+				VariableValues: tt.variables,
+				Context:        context.Background(),
+			})
+			if tt.expectedError != "" {
+				require.Len(t, res.Errors, 1)
+				assert.Equal(t, tt.expectedError, res.Errors[0].Message)
+				assert.Equal(t, tt.expectedExt, res.Errors[0].Extensions)
+				assert.Nil(t, res.Data)
+				assert.Len(t, mockDriver.Calls, calls, "rejected operation must not call functions")
+				result, ok := res.Extensions["cost"].(*router.CostResult)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedExt["cost"], result.RequestedQueryCost)
+				return
+			}
+			require.Empty(t, res.Errors)
+			result, ok := res.Extensions["cost"].(*router.CostResult)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedCost, result.RequestedQueryCost)
+			assert.Equal(t, tt.config.MaxCost, result.MaximumAvailable)
+		})
+	}
+}
+
+func TestCostExtensionRejectsBeforeExecution(t *testing.T) {
+	env := router.Environment{
+		Provider: "cost",
+		Runtime:  "reject",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	broker := pubsub.New()
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Broker:      broker,
+		Cost:        &router.CostConfig{MaxRootFields: 1},
+		Resolvers: map[string]router.ResolverConfig{
+			"Mutation.send": {Publish: &router.PublishConfig{Topic: "messages"}},
+		},
+		Schema: `
+type Message {
+	text: String
+}
+type Query {
+	field: String
+}
+type Mutation {
+	send(text: String!): Message
+}
+schema {
+	query: Query
+	mutation: Mutation
+}
+`,
+	})
+	require.NoError(t, err)
+	sub := broker.Subscribe("messages")
+	defer sub.Close()
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `mutation { a: send(text: "a") { text } b: send(text: "b") { text } }`,
+		Context:       context.Background(),
+	})
+	assert.Nil(t, res.Data)
+	if assert.Len(t, res.Errors, 1) {
+		assert.Equal(t, "operation has 2 root fields, maximum is 1", res.Errors[0].Message)
+	}
+	// first message received by subscriber is published after rejected mutation
+	broker.Publish("messages", "after")
+	require.True(t, sub.Next())
+	payload, err := sub.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "after", payload)
+}
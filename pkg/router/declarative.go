@@ -0,0 +1,55 @@
+package router
+
+import (
+	"github.com/graphql-editor/stucco/pkg/utils"
+	"github.com/graphql-go/graphql"
+)
+
+// resolves returns true if config defines field resolver and not only authorize function
+func (r ResolverConfig) resolves() bool {
+	return r.Resolve.Name != "" || r.Skip || r.Function != nil || r.Publish != nil || r.declarative()
+}
+
+// declarative returns true if resolver is evaluated by router without calling a function
+func (r ResolverConfig) declarative() bool {
+	return r.Value != nil || r.Path != "" || len(r.Arguments) != 0
+}
+
+// declarativeFieldResolver returns resolver that evaluates constant value, path projection
+// of source and argument mapping in router without a driver call
+func declarativeFieldResolver(rs ResolverConfig) (graphql.FieldResolveFn, error) {
+	var sourcePath utils.ValuePath
+	if rs.Path != "" {
+		var err error
+		if sourcePath, err = utils.ParseValuePath(rs.Path); err != nil {
+			return nil, err
+		}
+	}
+	arguments := make(map[string]utils.ValuePath, len(rs.Arguments))
+	for k, p := range rs.Arguments {
+		vp, err := utils.ParseValuePath(p)
+		if err != nil {
+			return nil, err
+		}
+		arguments[k] = vp
+	}
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		v := rs.Value
+		if rs.Path != "" {
+			v = sourcePath.Get(params.Source)
+		}
+		if len(arguments) == 0 {
+			return v, nil
+		}
+		obj := make(map[string]interface{}, len(arguments))
+		if m, ok := v.(map[string]interface{}); ok {
+			for k, e := range m {
+				obj[k] = e
+			}
+		}
+		for k, vp := range arguments {
+			obj[k] = vp.Get(params.Args)
+		}
+		return obj, nil
+	}, nil
+}
@@ -0,0 +1,112 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeclarativeResolvers(t *testing.T) {
+	env := router.Environment{
+		Provider: "declarative",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Response: map[string]interface{}{
+		"data": map[string]interface{}{
+			"items": []interface{}{
+				map[string]interface{}{"name": "first"},
+				map[string]interface{}{"name": "second"},
+			},
+		},
+	}})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.version": {Value: "1.0.0"},
+			"Query.list":    {Resolve: types.Function{Name: "list"}},
+			"Query.echo": {
+				Value:     map[string]interface{}{"kind": "echo"},
+				Arguments: map[string]string{"text": "input.text", "first": "input.tags[0]"},
+			},
+			"List.first":   {Path: "data.items[0].name"},
+			"List.missing": {Path: "$.data.items[5].name"},
+			"List.names":   {Path: "data.items"},
+			"Item.name":    {Path: "name"},
+		},
+		Schema: `
+input EchoInput {
+	text: String
+	tags: [String!]
+}
+type Echo {
+	kind: String
+	text: String
+	first: String
+}
+type Item {
+	name: String
+}
+type List {
+	first: String
+	missing: String
+	names: [Item!]!
+}
+type Query {
+	version: String
+	list: List
+	echo(input: EchoInput): Echo
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `{ version list { first missing names { name } } echo(input: {text: "hello", tags: ["a", "b"]}) { kind text first } }`,
+		Context:       context.Background(),
+	})
+	require.Empty(t, res.Errors)
+	assert.Equal(t, map[string]interface{}{
+		"version": "1.0.0",
+		"list": map[string]interface{}{
+			"first":   "first",
+			"missing": nil,
+			"names": []interface{}{
+				map[string]interface{}{"name": "first"},
+				map[string]interface{}{"name": "second"},
+			},
+		},
+		"echo": map[string]interface{}{
+			"kind":  "echo",
+			"text":  "hello",
+			"first": "a",
+		},
+	}, res.Data)
+	mockDriver.AssertNumberOfCalls(t, "FieldResolve", 1)
+}
+
+func TestDeclarativeResolverInvalidPath(t *testing.T) {
+	_, err := router.NewRouter(router.Config{
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.a": {Path: "a[x]"},
+		},
+		Schema: `type Query { a: String } schema { query: Query }`,
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,269 @@
+package router
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+type deferredKey int
+
+// deferredContextKey is a context key of deferred fragments of operation
+const deferredContextKey deferredKey = 0
+
+// Deferred collects fragments marked with @defer directive in selections of resolved
+// fields. Fragments are executed on resolved values of fields after result of operation
+// is delivered, so fields on path to fragment are not resolved again.
+type Deferred struct {
+	lock      sync.Mutex
+	path      []interface{}
+	schemas   *objectSchemas
+	fragments []*DeferredFragment
+}
+
+// NewDeferred returns new collector of deferred fragments of operation
+func NewDeferred() *Deferred {
+	return &Deferred{
+		path:    []interface{}{},
+		schemas: new(objectSchemas),
+	}
+}
+
+// WithDeferred returns context in which fragments marked with @defer directive
+// in selections of resolved fields are added to deferred
+func WithDeferred(ctx context.Context, d *Deferred) context.Context {
+	return context.WithValue(ctx, deferredContextKey, d)
+}
+
+func deferredFromContext(ctx context.Context) *Deferred {
+	if ctx == nil {
+		return nil
+	}
+	d, _ := ctx.Value(deferredContextKey).(*Deferred)
+	return d
+}
+
+// Take removes and returns fragments deferred on values present in data of operation
+// result. Values missing from data were nulled by errors and their fragments are dropped.
+func (d *Deferred) Take(data interface{}) []*DeferredFragment {
+	d.lock.Lock()
+	fragments := d.fragments
+	d.fragments = nil
+	d.lock.Unlock()
+	var out []*DeferredFragment
+	for _, f := range fragments {
+		if _, ok := valueAt(data, f.Path[len(d.path):]).(map[string]interface{}); ok {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func valueAt(v interface{}, path []interface{}) interface{} {
+	for _, key := range path {
+		switch k := key.(type) {
+		case string:
+			m, _ := v.(map[string]interface{})
+			v = m[k]
+		case int:
+			l, _ := v.([]interface{})
+			if k >= len(l) {
+				return nil
+			}
+			v = l[k]
+		}
+	}
+	return v
+}
+
+// collect adds fragments deferred in selections of field to deferred for every
+// object in resolved value of field
+func (d *Deferred) collect(ctx context.Context, info graphql.ResolveInfo, v interface{}) {
+	var fragments []*ast.InlineFragment
+	for _, f := range info.FieldASTs {
+		fragments = append(fragments, deferredMarkers(info, f.SelectionSet, nil)...)
+	}
+	if len(fragments) == 0 {
+		return
+	}
+	params := graphql.ResolveParams{Info: info, Context: ctx}
+	path := append(append([]interface{}{}, d.path...), info.Path.AsArray()...)
+	d.collectValue(params, info.ReturnType, v, path)
+}
+
+func (d *Deferred) collectValue(params graphql.ResolveParams, t graphql.Type, v interface{}, path []interface{}) {
+	if nonNull, ok := t.(*graphql.NonNull); ok {
+		t = nonNull.OfType
+	}
+	if v == nil {
+		return
+	}
+	switch tt := t.(type) {
+	case *graphql.List:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return
+		}
+		for i := 0; i < rv.Len(); i++ {
+			d.collectValue(params, tt.OfType, rv.Index(i).Interface(), appendPath(path, i))
+		}
+	case *graphql.Interface, *graphql.Union:
+		if object := resolveObjectType(params, tt.(graphql.Abstract), v); object != nil {
+			d.add(params, object, v, path)
+		}
+	case *graphql.Object:
+		d.add(params, tt, v, path)
+	}
+}
+
+func (d *Deferred) add(params graphql.ResolveParams, object *graphql.Object, v interface{}, path []interface{}) {
+	base := DeferredFragment{
+		Path:     path,
+		object:   object,
+		value:    v,
+		info:     params.Info,
+		claims:   claimsFromContext(params.Context),
+		protocol: params.Context.Value(ProtocolKey),
+		schemas:  d.schemas,
+	}
+	var fragments []*DeferredFragment
+	for _, f := range params.Info.FieldASTs {
+		for _, m := range deferredMarkers(params.Info, f.SelectionSet, object) {
+			fragments = append(fragments, base.with(m))
+		}
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.fragments = append(d.fragments, fragments...)
+}
+
+// deferredMarkers returns fragments marked with @defer directive which were skipped in
+// selection set to be executed on resolved values. Inline fragments which are not deferred
+// are searched if their type condition applies to object or object is not known yet.
+func deferredMarkers(info graphql.ResolveInfo, ss *ast.SelectionSet, object *graphql.Object) []*ast.InlineFragment {
+	if ss == nil {
+		return nil
+	}
+	var markers []*ast.InlineFragment
+	for _, sel := range ss.Selections {
+		f, ok := sel.(*ast.InlineFragment)
+		if !ok || (object != nil && !fragmentApplies(info.Schema, f, object)) {
+			continue
+		}
+		if isDeferredMarker(f, info.VariableValues) {
+			markers = append(markers, f)
+		} else if !excluded(f.Directives, info.VariableValues) {
+			markers = append(markers, deferredMarkers(info, f.SelectionSet, object)...)
+		}
+	}
+	return markers
+}
+
+func fragmentApplies(schema graphql.Schema, f *ast.InlineFragment, object *graphql.Object) bool {
+	if f.TypeCondition == nil {
+		return true
+	}
+	switch t := schema.Type(f.TypeCondition.Name.Value).(type) {
+	case *graphql.Object:
+		return t.Name() == object.Name()
+	case *graphql.Interface, *graphql.Union:
+		for _, possible := range schema.PossibleTypes(t.(graphql.Abstract)) {
+			if possible.Name() == object.Name() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DeferredFragment is a fragment marked with @defer directive on resolved value of field
+type DeferredFragment struct {
+	Label string
+	// Path is a path of value on which fragment is executed
+	Path     []interface{}
+	fragment *ast.InlineFragment
+	object   *graphql.Object
+	value    interface{}
+	info     graphql.ResolveInfo
+	claims   interface{}
+	protocol interface{}
+	schemas  *objectSchemas
+}
+
+func (f DeferredFragment) with(fragment *ast.InlineFragment) *DeferredFragment {
+	args, _ := activeDirective(fragment.Directives, "defer", f.info.VariableValues)
+	f.Label, _ = args["label"].(string)
+	f.fragment = fragment
+	return &f
+}
+
+// context returns context of request in which fragment is executed
+func (f *DeferredFragment) context(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, ContextKey, &Context{Claims: f.claims})
+	if f.protocol != nil {
+		ctx = context.WithValue(ctx, ProtocolKey, f.protocol)
+	}
+	return ctx
+}
+
+// Execute executes selections of fragment on resolved value, streamed lists in fragment
+// are added to streams. Returned fragments were deferred in selections of this fragment and
+// their results must be delivered after result of this fragment.
+func (f *DeferredFragment) Execute(ctx context.Context, streams *Streams) (IncrementalResult, []*DeferredFragment) {
+	out := IncrementalResult{Path: f.Path, Label: f.Label}
+	schema, err := f.schemas.schema(f.info.Schema, f.object)
+	if err != nil {
+		out.Errors = []gqlerrors.FormattedError{{Message: err.Error(), Path: f.Path}}
+		return out, nil
+	}
+	deferred := &Deferred{path: f.Path, schemas: f.schemas}
+	selectionSet := ast.NewSelectionSet(&ast.SelectionSet{Selections: []ast.Selection{
+		ast.NewInlineFragment(&ast.InlineFragment{
+			TypeCondition: f.fragment.TypeCondition,
+			SelectionSet:  f.fragment.SelectionSet,
+		}),
+	}})
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:  *schema,
+		Root:    f.value,
+		AST:     selectionDocument(f.info, selectionSet),
+		Args:    f.info.VariableValues,
+		Context: WithStreams(WithDeferred(f.context(ctx), deferred), streams),
+	})
+	out.Errors = prefixErrorPaths(result.Errors, f.Path)
+	data, ok := result.Data.(map[string]interface{})
+	if !ok {
+		return out, nil
+	}
+	out.Data = data
+	// fragments deferred directly in this fragment are executed on the same value
+	var fragments []*DeferredFragment
+	for _, m := range deferredMarkers(f.info, f.fragment.SelectionSet, f.object) {
+		fragments = append(fragments, f.with(m))
+	}
+	return out, append(fragments, deferred.Take(data)...)
+}
+
+// deferExtension collects fragments marked with @defer directive in selections of resolved
+// fields if operation is executed in context with deferred
+type deferExtension struct {
+	baseExtension
+}
+
+func (deferExtension) Name() string { return "DeferExtension" }
+
+func (deferExtension) ResolveFieldDidStart(ctx context.Context, info *graphql.ResolveInfo) (context.Context, graphql.ResolveFieldFinishFunc) {
+	deferred := deferredFromContext(ctx)
+	if deferred == nil {
+		return ctx, func(interface{}, error) {}
+	}
+	return ctx, func(v interface{}, err error) {
+		if err == nil {
+			deferred.collect(ctx, *info, v)
+		}
+	}
+}
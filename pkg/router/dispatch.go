@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -39,6 +40,19 @@ type Dispatch struct {
 	MaxDepth int // Maximum depth for GraphQL recursion
 }
 
+func (d Dispatch) contextDriver() driver.ContextDriver {
+	return driver.WithContext(d.Driver)
+}
+
+// requestContext returns context of a request or background context
+// if none is available.
+func requestContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return ctx
+}
+
 func assertTypeRef(t *types.TypeRef) types.TypeRef {
 	if t == nil {
 		panic("assertion failed, TypeRef cannot be null here")
@@ -57,6 +71,18 @@ func mustMakeTypeRefFromNamed(named *ast.Named) types.TypeRef {
 	return assertTypeRef(makeTypeRefFromNamed(named))
 }
 
+func makeTypeRefFromAST(t ast.Type) *types.TypeRef {
+	switch tt := t.(type) {
+	case *ast.NonNull:
+		return &types.TypeRef{NonNull: makeTypeRefFromAST(tt.Type)}
+	case *ast.List:
+		return &types.TypeRef{List: makeTypeRefFromAST(tt.Type)}
+	case *ast.Named:
+		return makeTypeRefFromNamed(tt)
+	}
+	return nil
+}
+
 func makeTypeRefFromType(t graphql.Type) *types.TypeRef {
 	if t == nil {
 		return nil
@@ -103,6 +129,7 @@ func makeVariableDefinition(v *ast.VariableDefinition) types.VariableDefinition
 		Variable: types.Variable{
 			Name: v.Variable.Name.Value,
 		},
+		Type:         makeTypeRefFromAST(v.Type),
 		DefaultValue: v.DefaultValue,
 	}
 }
@@ -143,6 +170,7 @@ func makeSelection(sel ast.Selection, fragments map[string]ast.Definition) (s ty
 	case *ast.Field:
 		s = types.Selection{
 			Name:         st.Name.Value,
+			Alias:        alias(st),
 			Arguments:    makeArguments(st.Arguments),
 			Directives:   makeDirectives(st.Directives),
 			SelectionSet: makeSelections(st.SelectionSet, fragments),
@@ -159,10 +187,15 @@ func makeSelection(sel ast.Selection, fragments map[string]ast.Definition) (s ty
 			},
 		}
 	case *ast.InlineFragment:
+		// inline fragments, like ones marked with @defer, may omit type condition
+		var typeCondition types.TypeRef
+		if st.TypeCondition != nil {
+			typeCondition = mustMakeTypeRefFromNamed(st.TypeCondition)
+		}
 		s = types.Selection{
 			Directives: makeDirectives(st.Directives),
 			Definition: &types.FragmentDefinition{
-				TypeCondition: mustMakeTypeRefFromNamed(st.TypeCondition),
+				TypeCondition: typeCondition,
 				SelectionSet:  makeSelections(st.SelectionSet, fragments),
 			},
 		}
@@ -170,6 +203,13 @@ func makeSelection(sel ast.Selection, fragments map[string]ast.Definition) (s ty
 	return
 }
 
+func alias(f *ast.Field) string {
+	if f.Alias == nil {
+		return ""
+	}
+	return f.Alias.Value
+}
+
 func makeSelections(selectionSet *ast.SelectionSet, fragments map[string]ast.Definition) types.Selections {
 	if selectionSet == nil {
 		return nil
@@ -198,12 +238,12 @@ func makeOperationDefinition(odef *ast.OperationDefinition, fragments map[string
 	}
 }
 
-func buildFieldInfoParams(params graphql.ResolveInfo) driver.FieldResolveInfo {
+func buildFieldInfoParams(ctx context.Context, params graphql.ResolveInfo) driver.FieldResolveInfo {
 	info := driver.FieldResolveInfo{
 		FieldName:      params.FieldName,
 		ReturnType:     makeTypeRefFromType(params.ReturnType),
 		ParentType:     makeTypeRefFromType(params.ParentType),
-		VariableValues: params.VariableValues,
+		VariableValues: parseScalarVariables(ctx, params.VariableValues),
 		Path:           newResposnePath(params.Path),
 		RootValue:      params.RootValue,
 	}
@@ -231,11 +271,21 @@ func (d Dispatch) checkDepth(params graphql.ResolveParams) error {
 
 // Authorize creates a function that calls implementation of field resolution through driver
 func (d Dispatch) Authorize(auth AuthorizeConfig) func(params *graphql.Params) (bool, error) {
+	authorize := d.AuthorizeClaims(auth)
 	return func(params *graphql.Params) (bool, error) {
+		ok, _, err := authorize(params)
+		return ok, err
+	}
+}
+
+// AuthorizeClaims creates a function that calls authorize function through driver
+// and returns claims of authorized request
+func (d Dispatch) AuthorizeClaims(auth AuthorizeConfig) func(params *graphql.Params) (bool, interface{}, error) {
+	return func(params *graphql.Params) (bool, interface{}, error) {
 		if auth.Authorize.Name == "" {
-			return true, nil
+			return true, nil, nil
 		}
-		out := d.Driver.Authorize(driver.AuthorizeInput{
+		out := d.contextDriver().AuthorizeContext(requestContext(params.Context), driver.AuthorizeInput{
 			Function: types.Function{
 				Name: auth.Authorize.Name,
 			},
@@ -245,16 +295,60 @@ func (d Dispatch) Authorize(auth AuthorizeConfig) func(params *graphql.Params) (
 			Protocol:       params.Context.Value(ProtocolKey),
 		})
 		if out.Error != nil {
-			return false, errors.New(out.Error.Message)
+			return false, nil, driverError{err: out.Error}
 		}
-		return out.Response, nil
+		return out.Response, out.Claims, nil
+	}
+}
+
+// FieldAuthorize creates a resolver that calls authorize function through driver
+// before calling next resolver
+func (d Dispatch) FieldAuthorize(fn types.Function, next graphql.FieldResolveFn) graphql.FieldResolveFn {
+	if next == nil {
+		next = graphql.DefaultResolveFn
+	}
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		if err := getRouterError(params.Context); err != nil {
+			return nil, err
+		}
+		info := buildFieldInfoParams(params.Context, params.Info)
+		input := driver.AuthorizeInput{
+			Function:       fn,
+			VariableValues: parseScalarVariables(params.Context, params.Info.VariableValues),
+			Source:         params.Source,
+			Arguments:      types.Arguments(params.Args),
+			Info:           &info,
+			Claims:         claimsFromContext(params.Context),
+		}
+		if info.Operation != nil {
+			input.OperationName = info.Operation.Name
+		}
+		if params.Context != nil {
+			input.Protocol = params.Context.Value(ProtocolKey)
+			if rtContext, _ := params.Context.Value(ContextKey).(*Context); rtContext != nil && rtContext.params != nil {
+				input.Query = rtContext.params.RequestString
+			}
+		}
+		out := d.contextDriver().AuthorizeContext(requestContext(params.Context), input)
+		if out.Error != nil {
+			return nil, fieldError(params.Info, out.Error)
+		}
+		if !out.Response {
+			return nil, driverError{err: &driver.Error{
+				Message: "unauthorized",
+				Code:    Unauthorized,
+			}}
+		}
+		return next(params)
 	}
 }
 
 // FieldResolve creates a function that calls implementation of field resolution through driver
 func (d Dispatch) FieldResolve(rs ResolverConfig) func(params graphql.ResolveParams) (interface{}, error) {
 	return func(params graphql.ResolveParams) (interface{}, error) {
-		assertRouterOk(params.Context)
+		if err := getRouterError(params.Context); err != nil {
+			return nil, err
+		}
 		if err := d.checkDepth(params); err != nil {
 			return nil, err
 		}
@@ -265,15 +359,17 @@ func (d Dispatch) FieldResolve(rs ResolverConfig) func(params graphql.ResolvePar
 				if params.Info.RootValue != nil {
 					subCtx.resolvedTo, _ = graphql.DefaultResolveFn(params)
 				}
+				subCtx.arguments = params.Args
 				return nil, nil
 			}
 		}
-		info := buildFieldInfoParams(params.Info)
+		info := buildFieldInfoParams(params.Context, params.Info)
 		input := driver.FieldResolveInput{
 			Function:  rs.Resolve,
 			Source:    params.Source,
 			Arguments: types.Arguments(params.Args),
 			Info:      info,
+			Claims:    claimsFromContext(params.Context),
 		}
 		if isSubscription(&params.Info) {
 			input.SubscriptionPayload = params.Context.Value(SubscriptionPayloadKey)
@@ -282,29 +378,38 @@ func (d Dispatch) FieldResolve(rs ResolverConfig) func(params graphql.ResolvePar
 			input.Protocol = params.Context.Value(ProtocolKey)
 			input.SubscriptionPayload = params.Context.Value(SubscriptionPayloadKey)
 		}
-		var err error
-		out := d.Driver.FieldResolve(input)
-		var i interface{}
-		if err == nil {
-			if out.Error != nil {
-				err = fmt.Errorf(out.Error.Message)
-			} else {
-				i = out.Response
-			}
-		}
-		if err != nil {
-			err = errors.Wrap(err, rs.Resolve.Name)
+		ctx := requestContext(params.Context)
+		if batches := getFieldBatcher(params.Context); rs.Batch && batches != nil {
+			// resolution is deferred until graphql-go dethunks the result,
+			// by then all siblings of this field have been added to the batch
+			key := params.Info.ParentType.Name() + "." + params.Info.FieldName
+			batch, idx := batches.add(key, input)
+			return func() (interface{}, error) {
+				v, err := fieldResolveResult(params.Info, batches.resolve(ctx, d.Driver, key, batch, idx))
+				if err != nil {
+					// graphql-go drops extensions and path of errors returned from thunks
+					raiseFieldError(err)
+				}
+				return v, nil
+			}, nil
 		}
-		return i, err
+		return fieldResolveResult(params.Info, d.contextDriver().FieldResolveContext(ctx, input))
 	}
 }
 
-func buildInterfaceInfoParams(params graphql.ResolveInfo) driver.InterfaceResolveTypeInfo {
+func fieldResolveResult(info graphql.ResolveInfo, out driver.FieldResolveOutput) (interface{}, error) {
+	if out.Error != nil {
+		return nil, fieldError(info, out.Error)
+	}
+	return responseErrorItems(info, out.Response), nil
+}
+
+func buildInterfaceInfoParams(ctx context.Context, params graphql.ResolveInfo) driver.InterfaceResolveTypeInfo {
 	info := driver.InterfaceResolveTypeInfo{
 		FieldName:      params.FieldName,
 		ReturnType:     makeTypeRefFromType(params.ReturnType),
 		ParentType:     makeTypeRefFromType(params.ParentType),
-		VariableValues: params.VariableValues,
+		VariableValues: parseScalarVariables(ctx, params.VariableValues),
 	}
 	path := newResposnePath(params.Path)
 	info.Path = path
@@ -315,86 +420,81 @@ func buildInterfaceInfoParams(params graphql.ResolveInfo) driver.InterfaceResolv
 	return info
 }
 
+// resolvedType returns object type resolved by function. graphql.ResolveTypeFn cannot return
+// an error and nil type is reported by graphql-go with a generic message, so to report
+// error of function to client it is raised as an error of field being completed.
+func (d Dispatch) resolvedType(info graphql.ResolveInfo, typ types.TypeRef, err *driver.Error) *graphql.Object {
+	if err != nil {
+		raiseFieldError(fieldError(info, err))
+	}
+	t, ok := d.TypeMap.Type(typ.Name).(*graphql.Object)
+	if !ok || t == nil {
+		raiseFieldError(fmt.Errorf("%q is not a valid type name", typ.Name))
+	}
+	return t
+}
+
 // InterfaceResolveType creates a function that calls implementation of interface type resolution
 func (d Dispatch) InterfaceResolveType(i InterfaceConfig) func(params graphql.ResolveTypeParams) *graphql.Object {
 	return func(params graphql.ResolveTypeParams) *graphql.Object {
-		assertRouterOk(params.Context)
 		input := driver.InterfaceResolveTypeInput{
 			Function: i.ResolveType,
 			Value:    params.Value,
-			Info:     buildInterfaceInfoParams(params.Info),
-		}
-		var err error
-		out := d.Driver.InterfaceResolveType(input)
-		if out.Error != nil {
-			err = fmt.Errorf(out.Error.Message)
+			Info:     buildInterfaceInfoParams(params.Context, params.Info),
+			Claims:   claimsFromContext(params.Context),
 		}
-		var t *graphql.Object
-		if err == nil {
-			var ok bool
-			t, ok = d.TypeMap.Type(out.Type.Name).(*graphql.Object)
-			if !ok || t == nil {
-				err = fmt.Errorf("\"out.Type.Name\" is not a valid type name")
-			}
-		}
-		if err != nil {
-			err = errors.Wrap(err, i.ResolveType.Name)
-			panic(err)
-		}
-		return t
+		out := d.contextDriver().InterfaceResolveTypeContext(requestContext(params.Context), input)
+		return d.resolvedType(params.Info, out.Type, out.Error)
 	}
 }
 
-// ScalarFunctions creates parse and serialize scalar functions that call implementation of scalar and parse through driver
+// ScalarFunctions creates parse and serialize scalar functions that call implementation of scalar and parse through driver.
+// Values are parsed and serialized with context of request carried by scalarInput and scalarOutput.
 func (d Dispatch) ScalarFunctions(s ScalarConfig) parser.ScalarFunctions {
+	parse := func(ctx context.Context, v interface{}) (interface{}, error) {
+		out := d.contextDriver().ScalarParseContext(requestContext(ctx), driver.ScalarParseInput{
+			Function: s.Parse,
+			Value:    v,
+			Claims:   claimsFromContext(ctx),
+		})
+		if out.Error != nil {
+			return nil, driverError{err: out.Error}
+		}
+		return out.Response, nil
+	}
 	return parser.ScalarFunctions{
 		Parse: func(v interface{}) interface{} {
-			var err error
-			out := d.Driver.ScalarParse(driver.ScalarParseInput{
-				Function: s.Parse,
-				Value:    v,
-			})
-			if err == nil {
-				if out.Error != nil {
-					err = fmt.Errorf(out.Error.Message)
-				}
+			// value is already waiting to be parsed if operation is executed again,
+			// like items of a stream
+			if in, ok := v.(*scalarInput); ok {
+				return in
 			}
-			if err != nil {
-				err = errors.Wrap(err, s.Parse.Name)
-				// panic on error as there is no other way to
-				// pass error from parse function to graphql-go
-				panic(err)
-			}
-			return out.Response
+			return &scalarInput{value: v, parse: parse}
 		},
 		Serialize: func(v interface{}) interface{} {
-			var err error
-			out := d.Driver.ScalarSerialize(driver.ScalarSerializeInput{
+			ctx := context.Background()
+			if out, ok := v.(*scalarOutput); ok {
+				ctx, v = out.ctx, out.value
+			}
+			out := d.contextDriver().ScalarSerializeContext(requestContext(ctx), driver.ScalarSerializeInput{
 				Function: s.Serialize,
 				Value:    v,
+				Claims:   claimsFromContext(ctx),
 			})
-			if err == nil {
-				if out.Error != nil {
-					err = fmt.Errorf(out.Error.Message)
-				}
-			}
-			if err != nil {
-				err = errors.Wrap(err, s.Parse.Name)
-				// panic on error as there is no other way to
-				// pass error from parse function to graphql-go
-				panic(err)
+			if out.Error != nil {
+				raiseFieldError(driverError{err: out.Error})
 			}
 			return out.Response
 		},
 	}
 }
 
-func buildUnionInfoParams(params graphql.ResolveInfo) driver.UnionResolveTypeInfo {
+func buildUnionInfoParams(ctx context.Context, params graphql.ResolveInfo) driver.UnionResolveTypeInfo {
 	info := driver.UnionResolveTypeInfo{
 		FieldName:      params.FieldName,
 		ReturnType:     makeTypeRefFromType(params.ReturnType),
 		ParentType:     makeTypeRefFromType(params.ParentType),
-		VariableValues: params.VariableValues,
+		VariableValues: parseScalarVariables(ctx, params.VariableValues),
 	}
 	path := newResposnePath(params.Path)
 	info.Path = path
@@ -408,29 +508,13 @@ func buildUnionInfoParams(params graphql.ResolveInfo) driver.UnionResolveTypeInf
 // UnionResolveType creates a function that calls union resolution using driver
 func (d Dispatch) UnionResolveType(u UnionConfig) func(params graphql.ResolveTypeParams) *graphql.Object {
 	return func(params graphql.ResolveTypeParams) *graphql.Object {
-		assertRouterOk(params.Context)
 		input := driver.UnionResolveTypeInput{
 			Function: u.ResolveType,
 			Value:    params.Value,
-			Info:     buildUnionInfoParams(params.Info),
-		}
-		var err error
-		out := d.Driver.UnionResolveType(input)
-		if err == nil && out.Error != nil {
-			err = fmt.Errorf(out.Error.Message)
-		}
-		var t *graphql.Object
-		if err == nil {
-			var ok bool
-			t, ok = d.TypeMap.Type(out.Type.Name).(*graphql.Object)
-			if !ok || t == nil {
-				err = fmt.Errorf("\"out.Type.Name\" is not a valid type name")
-			}
-		}
-		if err != nil {
-			err = errors.Wrap(err, u.ResolveType.Name)
-			panic(err)
+			Info:     buildUnionInfoParams(params.Context, params.Info),
+			Claims:   claimsFromContext(params.Context),
 		}
-		return t
+		out := d.contextDriver().UnionResolveTypeContext(requestContext(params.Context), input)
+		return d.resolvedType(params.Info, out.Type, out.Error)
 	}
 }
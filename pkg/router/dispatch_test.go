@@ -536,71 +536,23 @@ func TestDispatchUnionResolveType(t *testing.T) {
 }
 
 func TestDispatchScalarParse(t *testing.T) {
-	data := []struct {
-		title         string
-		config        router.ScalarConfig
-		input         interface{}
-		driverInput   driver.ScalarParseInput
-		driverOutput  driver.ScalarParseOutput
-		expected      interface{}
-		expectedPanic func(assert.TestingT, assert.PanicTestFunc, ...interface{}) bool
-	}{
-		{
-			title: "CallsScalarParse",
-			config: router.ScalarConfig{
-				Parse: types.Function{
-					Name: "function",
-				},
-			},
-			input: "scalar val",
-			driverInput: driver.ScalarParseInput{
-				Function: types.Function{
-					Name: "function",
-				},
-				Value: "scalar val",
-			},
-			driverOutput: driver.ScalarParseOutput{
-				Response: "scalar response",
-			},
-			expected:      "scalar response",
-			expectedPanic: assert.NotPanics,
+	// graphql-go parses values without context of request, function is called
+	// once field using value is resolved
+	mockDriver := new(drivertest.MockDriver)
+	fns := router.Dispatch{
+		Driver: mockDriver,
+	}.ScalarFunctions(router.ScalarConfig{
+		Parse: types.Function{
+			Name: "function",
 		},
-		{
-			title: "PanicsOnError",
-			config: router.ScalarConfig{
-				Parse: types.Function{
-					Name: "function",
-				},
-			},
-			input: "scalar val",
-			driverInput: driver.ScalarParseInput{
-				Function: types.Function{
-					Name: "function",
-				},
-				Value: "scalar val",
-			},
-			driverOutput: driver.ScalarParseOutput{
-				Error: &driver.Error{
-					Message: "some error",
-				},
-			},
-			expectedPanic: assert.Panics,
-		},
-	}
-	for i := range data {
-		tt := data[i]
-		t.Run(tt.title, func(t *testing.T) {
-			mockDriver := new(drivertest.MockDriver)
-			mockDriver.On("ScalarParse", tt.driverInput).Return(tt.driverOutput, nil)
-			var out interface{}
-			tt.expectedPanic(t, func() {
-				out = router.Dispatch{
-					Driver: mockDriver,
-				}.ScalarFunctions(tt.config).Parse(tt.input)
-			})
-			assert.Equal(t, tt.expected, out)
-		})
-	}
+	})
+	var out interface{}
+	assert.NotPanics(t, func() {
+		out = fns.Parse("scalar val")
+	})
+	assert.NotNil(t, out)
+	assert.Equal(t, out, fns.Parse(out))
+	mockDriver.AssertNotCalled(t, "ScalarParse", mock.Anything)
 }
 
 func TestDispatchScalarSerialize(t *testing.T) {
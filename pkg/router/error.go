@@ -0,0 +1,73 @@
+package router
+
+import (
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Unauthorized is a code of error returned when authorize function of a field
+// denies access to it
+const Unauthorized = "UNAUTHORIZED"
+
+// driverError is an error returned by function through driver. Code and extensions
+// of error are returned to client in error extensions.
+type driverError struct {
+	err *driver.Error
+}
+
+func (d driverError) Error() string {
+	return d.err.Message
+}
+
+// Extensions implements gqlerrors.ExtendedError
+func (d driverError) Extensions() map[string]interface{} {
+	if d.err.Code == "" && len(d.err.Extensions) == 0 {
+		return nil
+	}
+	ext := make(map[string]interface{}, len(d.err.Extensions)+1)
+	for k, v := range d.err.Extensions {
+		ext[k] = v
+	}
+	if d.err.Code != "" {
+		ext["code"] = d.err.Code
+	}
+	return ext
+}
+
+// fieldError creates error of a field from driver error. If driver error has a path, it is
+// appended to the path of field.
+func fieldError(info graphql.ResolveInfo, err *driver.Error) error {
+	derr := driverError{err: err}
+	if len(err.Path) == 0 || info.Path == nil {
+		return derr
+	}
+	nodes := make([]ast.Node, 0, len(info.FieldASTs))
+	for _, f := range info.FieldASTs {
+		nodes = append(nodes, f)
+	}
+	path := append(info.Path.AsArray(), err.Path...)
+	return gqlerrors.NewErrorWithPath(err.Message, nodes, "", nil, []int{}, path, derr)
+}
+
+// raiseFieldError reports error where graphql-go gives no way to return it:
+//   - graphql.ResolveTypeFn and graphql.SerializeFn return only a value,
+//   - errors returned from thunks are formatted before they are located,
+//     which drops their extensions and path.
+//
+// graphql-go recovers a panic while completing a field as an error of that field, so
+// field is set to null following normal null propagation while the rest of the
+// response is kept. Errors of functions that can return them must be returned.
+func raiseFieldError(err error) {
+	panic(err)
+}
+
+// formatError formats error keeping extensions of error
+func formatError(err error) gqlerrors.FormattedError {
+	ferr := gqlerrors.FormatError(err)
+	if ext, ok := err.(gqlerrors.ExtendedError); ok && ferr.Extensions == nil {
+		ferr.Extensions = ext.Extensions()
+	}
+	return ferr
+}
@@ -0,0 +1,389 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/pubsub"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriverErrorExtensions(t *testing.T) {
+	env := router.Environment{
+		Provider: "error",
+		Runtime:  "extensions",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "user"
+	})).Return(driver.FieldResolveOutput{Error: &driver.Error{
+		Message:    "user not found",
+		Code:       "NOT_FOUND",
+		Extensions: map[string]interface{}{"id": "1"},
+	}})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "users"
+	})).Return(driver.FieldResolveOutput{
+		Response: []interface{}{"a", "b"},
+		Error: &driver.Error{
+			Message: "forbidden",
+			Code:    "FORBIDDEN",
+			Path:    []interface{}{1},
+		},
+	})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.user":  {Resolve: types.Function{Name: "user"}},
+			"Query.users": {Resolve: types.Function{Name: "users"}},
+		},
+		Schema: `
+type Query {
+	user: String
+	users: [String]
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `{ user users }`,
+		Context:       context.Background(),
+	})
+	require.Len(t, res.Errors, 2)
+	errs := map[string]map[string]interface{}{}
+	paths := map[string][]interface{}{}
+	for _, err := range res.Errors {
+		assert.NotContains(t, err.Message, "user:")
+		errs[err.Message] = err.Extensions
+		paths[err.Message] = err.Path
+	}
+	assert.Equal(t, map[string]interface{}{"code": "NOT_FOUND", "id": "1"}, errs["user not found"])
+	assert.Equal(t, []interface{}{"user"}, paths["user not found"])
+	assert.Equal(t, map[string]interface{}{"code": "FORBIDDEN"}, errs["forbidden"])
+	assert.Equal(t, []interface{}{"users", 1}, paths["forbidden"])
+}
+
+func TestResponseObjectErrors(t *testing.T) {
+	env := router.Environment{
+		Provider: "error",
+		Runtime:  "response",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{
+		Response: []interface{}{
+			driver.ResponseObject{
+				"first":  "a",
+				"second": &driver.Error{Message: "second failed", Code: "SECOND"},
+			},
+			&driver.Error{Message: "item failed", Path: []interface{}{"first"}},
+		},
+	})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.items": {Resolve: types.Function{Name: "items"}},
+		},
+		Schema: `
+type Item {
+	name(upper: Boolean): String
+}
+type Query {
+	items: [Item]
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `{ items { first: name second: name(upper: true) } }`,
+		Context:       context.Background(),
+	})
+	assert.Equal(t, map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"first": "a", "second": nil},
+			nil,
+		},
+	}, res.Data)
+	require.Len(t, res.Errors, 2)
+	assert.Equal(t, "second failed", res.Errors[0].Message)
+	assert.Equal(t, []interface{}{"items", 0, "second"}, res.Errors[0].Path)
+	assert.Equal(t, map[string]interface{}{"code": "SECOND"}, res.Errors[0].Extensions)
+	assert.Equal(t, "item failed", res.Errors[1].Message)
+	assert.Equal(t, []interface{}{"items", 1, "first"}, res.Errors[1].Path)
+}
+
+func TestFieldScopedErrors(t *testing.T) {
+	env := router.Environment{
+		Provider: "error",
+		Runtime:  "scoped",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "items"
+	})).Return(driver.FieldResolveOutput{Response: []interface{}{
+		map[string]interface{}{"a": "valid"},
+		map[string]interface{}{"a": "invalid"},
+	}})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Response: "data"})
+	mockDriver.On("UnionResolveType", mock.MatchedBy(func(in driver.UnionResolveTypeInput) bool {
+		v, _ := in.Value.(map[string]interface{})
+		return v["a"] == "valid"
+	})).Return(driver.UnionResolveTypeOutput{Type: types.TypeRef{Name: "A"}})
+	mockDriver.On("UnionResolveType", mock.Anything).Return(driver.UnionResolveTypeOutput{Error: &driver.Error{
+		Message: "unknown item",
+		Code:    "BAD_ITEM",
+	}})
+	mockDriver.On("ScalarParse", mock.Anything).Return(driver.ScalarParseOutput{Error: &driver.Error{Message: "parse failed"}})
+	mockDriver.On("ScalarSerialize", mock.Anything).Return(driver.ScalarSerializeOutput{Error: &driver.Error{Message: "serialize failed"}})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.items":  {Resolve: types.Function{Name: "items"}},
+			"Query.scalar": {Resolve: types.Function{Name: "scalar"}},
+			"Query.arg":    {Resolve: types.Function{Name: "arg"}},
+			"Query.ok":     {Resolve: types.Function{Name: "ok"}},
+		},
+		Unions: map[string]router.UnionConfig{
+			"U": {ResolveType: types.Function{Name: "resolveType"}},
+		},
+		Scalars: map[string]router.ScalarConfig{
+			"S": {
+				Parse:     types.Function{Name: "parse"},
+				Serialize: types.Function{Name: "serialize"},
+			},
+		},
+		Schema: `
+type A {
+	a: String
+}
+union U = A
+scalar S
+type Query {
+	items: [U]
+	scalar: S
+	arg(s: S): String
+	ok: String
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	do := func(query string) *graphql.Result {
+		var res *graphql.Result
+		require.NotPanics(t, func() {
+			res = graphql.Do(graphql.Params{
+				Schema:        rt.Schema,
+				RequestString: query,
+				Context:       context.Background(),
+			})
+		})
+		return res
+	}
+
+	res := do(`{ items { ... on A { a } } scalar ok }`)
+	assert.Equal(t, map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"a": "valid"},
+			nil,
+		},
+		"scalar": nil,
+		"ok":     "data",
+	}, res.Data)
+	require.Len(t, res.Errors, 2)
+	errs := map[string]gqlerrors.FormattedError{}
+	for _, err := range res.Errors {
+		errs[err.Message] = err
+	}
+	assert.Equal(t, []interface{}{"items", 1}, errs["unknown item"].Path)
+	assert.Equal(t, map[string]interface{}{"code": "BAD_ITEM"}, errs["unknown item"].Extensions)
+	assert.Equal(t, []interface{}{"scalar"}, errs["serialize failed"].Path)
+
+	res = do(`{ arg(s: "value") ok }`)
+	assert.Equal(t, map[string]interface{}{
+		"arg": nil,
+		"ok":  "data",
+	}, res.Data)
+	if assert.Len(t, res.Errors, 1) {
+		assert.Equal(t, "parse failed", res.Errors[0].Message)
+		assert.Equal(t, []interface{}{"arg"}, res.Errors[0].Path)
+	}
+}
+
+func TestRequestErrorReplacesResult(t *testing.T) {
+	env := router.Environment{
+		Provider: "error",
+		Runtime:  "request",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("Authorize", mock.Anything).Return(driver.AuthorizeOutput{Error: &driver.Error{
+		Message: "token expired",
+		Code:    "UNAUTHENTICATED",
+	}})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Authorize: &router.AuthorizeConfig{
+			Authorize: types.Function{Name: "authorize"},
+		},
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.field": {Resolve: types.Function{Name: "field"}},
+		},
+		Schema: `
+type Query {
+	field: String
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `{ field }`,
+		Context:       context.Background(),
+	})
+	assert.Nil(t, res.Data)
+	if assert.Len(t, res.Errors, 1) {
+		assert.Equal(t, "token expired", res.Errors[0].Message)
+		assert.Equal(t, map[string]interface{}{"code": "UNAUTHENTICATED"}, res.Errors[0].Extensions)
+	}
+	mockDriver.AssertNotCalled(t, "FieldResolve", mock.Anything)
+}
+
+func TestRequestErrorAbortsExecution(t *testing.T) {
+	env := router.Environment{
+		Provider: "error",
+		Runtime:  "abort",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("Authorize", mock.Anything).Return(driver.AuthorizeOutput{Response: false})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	broker := pubsub.New()
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Broker:      broker,
+		Authorize: &router.AuthorizeConfig{
+			Authorize: types.Function{Name: "authorize"},
+		},
+		Resolvers: map[string]router.ResolverConfig{
+			"Mutation.send": {Publish: &router.PublishConfig{Topic: "messages"}},
+		},
+		Schema: `
+type Message {
+	text: String
+}
+type Query {
+	field: String
+}
+type Mutation {
+	send(text: String!): Message
+}
+schema {
+	query: Query
+	mutation: Mutation
+}
+`,
+	})
+	require.NoError(t, err)
+	sub := broker.Subscribe("messages")
+	defer sub.Close()
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `mutation { send(text: "x") { text } }`,
+		Context:       context.Background(),
+	})
+	assert.Nil(t, res.Data)
+	if assert.Len(t, res.Errors, 1) {
+		assert.Equal(t, "unauthorized", res.Errors[0].Message)
+	}
+	// first message received by subscriber is published after rejected mutation
+	broker.Publish("messages", "after")
+	require.True(t, sub.Next())
+	payload, err := sub.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "after", payload)
+}
+
+func TestSubscriptionError(t *testing.T) {
+	env := router.Environment{
+		Provider: "error",
+		Runtime:  "subscription",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("SubscriptionListen", mock.Anything).Return(driver.SubscriptionListenOutput{Error: &driver.Error{
+		Message: "topic not found",
+		Code:    "NOT_FOUND",
+	}})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Schema: `
+type Query {
+	field: String
+}
+type Subscription {
+	events: String
+}
+schema {
+	query: Query
+	subscription: Subscription
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `subscription { renamed: events }`,
+		Context:       context.Background(),
+	})
+	if assert.Len(t, res.Errors, 1) {
+		assert.Equal(t, "topic not found", res.Errors[0].Message)
+		assert.Equal(t, []interface{}{"renamed"}, res.Errors[0].Path)
+		assert.Equal(t, map[string]interface{}{"code": "NOT_FOUND"}, res.Errors[0].Extensions)
+	}
+}
@@ -0,0 +1,107 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-go/graphql"
+)
+
+// bindFederation binds resolvers of _service and _entities fields of federated subgraph
+func (r *Router) bindFederation() error {
+	if !r.federation {
+		return nil
+	}
+	fields := r.Schema.QueryType().Fields()
+	sdl := r.sdl
+	fields["_service"].Resolve = func(graphql.ResolveParams) (interface{}, error) {
+		return map[string]interface{}{"sdl": sdl}, nil
+	}
+	entity, _ := r.Schema.Type("_Entity").(*graphql.Union)
+	resolvers := make(map[string]graphql.FieldResolveFn, len(r.Entities))
+	for k, e := range r.Entities {
+		if entity == nil || !isPossibleType(entity, k) {
+			return fmt.Errorf("%s is not an entity", k)
+		}
+		dri, err := r.getDriver(driver.Config{
+			Provider: e.Environment.Provider,
+			Runtime:  e.Environment.Runtime,
+		})
+		if err != nil {
+			return err
+		}
+		resolvers[k] = Dispatch{
+			Driver:   r.dispatchDriver(dri, e.Environment, e.Policy),
+			TypeMap:  &r.Schema,
+			MaxDepth: r.MaxDepth,
+		}.FieldResolve(ResolverConfig{Resolve: e.ResolveReference})
+	}
+	if entities, ok := fields["_entities"]; ok {
+		entities.Resolve = resolveEntities(resolvers)
+	}
+	return nil
+}
+
+func isPossibleType(u *graphql.Union, name string) bool {
+	for _, t := range u.Types() {
+		if t.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveEntities resolves representations of entities with reference resolvers of their types.
+// Each entity is resolved lazily, so that errors are reported with entity path and
+// batched reference resolvers are called once.
+func resolveEntities(resolvers map[string]graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		representations, _ := params.Args["representations"].([]interface{})
+		entities := make([]interface{}, 0, len(representations))
+		for _, rep := range representations {
+			representation, _ := rep.(map[string]interface{})
+			typename, _ := representation["__typename"].(string)
+			if typename == "" {
+				return nil, errors.New("representation must be an object with __typename")
+			}
+			resolve, ok := resolvers[typename]
+			if !ok {
+				entities = append(entities, representation)
+				continue
+			}
+			p := params
+			p.Source = representation
+			p.Args = map[string]interface{}{"representation": representation}
+			v, err := resolve(p)
+			entities = append(entities, func() (interface{}, error) {
+				if thunk, ok := v.(func() (interface{}, error)); ok && err == nil {
+					v, err = thunk()
+				}
+				if err != nil {
+					// graphql-go drops extensions and path of errors returned from thunks
+					raiseFieldError(err)
+				}
+				return withTypename(v, typename), nil
+			})
+		}
+		return entities, nil
+	}
+}
+
+// withTypename adds __typename to resolved entity so that it can be resolved as _Entity
+func withTypename(v interface{}, typename string) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	if _, ok := m["__typename"]; ok {
+		return m
+	}
+	entity := make(map[string]interface{}, len(m)+1)
+	for k, e := range m {
+		entity[k] = e
+	}
+	entity["__typename"] = typename
+	return entity
+}
@@ -0,0 +1,99 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const federatedSchema = `type Product @key(fields: "upc") {
+	upc: String!
+	name: String
+}
+extend type User @key(fields: "id") {
+	id: ID! @external
+}
+type Query {
+	topProducts: [Product]
+}
+`
+
+func TestFederation(t *testing.T) {
+	env := router.Environment{
+		Provider: "federation",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		rep, _ := in.Source.(map[string]interface{})
+		return in.Function.Name == "product" && rep["upc"] == "1"
+	})).Return(driver.FieldResolveOutput{Response: map[string]interface{}{"upc": "1", "name": "Table"}})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{
+		Error: &driver.Error{Message: "not found", Code: "NOT_FOUND"},
+	})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Federation: &router.FederationConfig{
+			Entities: map[string]router.EntityConfig{
+				"Product": {ResolveReference: types.Function{Name: "product"}},
+			},
+		},
+		Schema: federatedSchema,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema: rt.Schema,
+		RequestString: `query($representations: [_Any!]!) {
+			_service { sdl }
+			_entities(representations: $representations) {
+				__typename
+				... on Product { name }
+				... on User { id }
+			}
+		}`,
+		VariableValues: map[string]interface{}{
+			"representations": []interface{}{
+				map[string]interface{}{"__typename": "Product", "upc": "1"},
+				map[string]interface{}{"__typename": "User", "id": "2"},
+				map[string]interface{}{"__typename": "Product", "upc": "3"},
+			},
+		},
+		Context: context.Background(),
+	})
+	assert.Equal(t, map[string]interface{}{
+		"_service": map[string]interface{}{"sdl": federatedSchema},
+		"_entities": []interface{}{
+			map[string]interface{}{"__typename": "Product", "name": "Table"},
+			map[string]interface{}{"__typename": "User", "id": "2"},
+			nil,
+		},
+	}, res.Data)
+	require.Len(t, res.Errors, 1)
+	assert.Equal(t, []interface{}{"_entities", 2}, res.Errors[0].Path)
+	assert.Equal(t, "NOT_FOUND", res.Errors[0].Extensions["code"])
+}
+
+func TestFederationUnknownEntity(t *testing.T) {
+	_, err := router.NewRouter(router.Config{
+		Federation: &router.FederationConfig{
+			Entities: map[string]router.EntityConfig{
+				"Query": {ResolveReference: types.Function{Name: "query"}},
+			},
+		},
+		Schema: federatedSchema,
+	})
+	assert.Error(t, err)
+}
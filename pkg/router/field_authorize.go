@@ -0,0 +1,57 @@
+package router
+
+import (
+	"strings"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/utils"
+	"github.com/graphql-go/graphql"
+)
+
+// typeWildcard used as a field name in resolvers config applies config to all fields of a type
+const typeWildcard = "*"
+
+func isTypeWildcard(k string) bool {
+	return strings.HasSuffix(k, "."+typeWildcard)
+}
+
+// fieldAuthorizeConfig returns config of authorize function for a field
+func (r *Router) fieldAuthorizeConfig(typeName, fieldName string) (ResolverConfig, bool) {
+	for _, k := range []string{
+		utils.FieldName(typeName, fieldName),
+		utils.FieldName(typeName, typeWildcard),
+	} {
+		if rs, ok := r.Resolvers[k]; ok && rs.Authorize != nil && rs.Authorize.Name != "" {
+			return rs, true
+		}
+	}
+	return ResolverConfig{}, false
+}
+
+// bindFieldAuthorizers wraps resolvers of fields with authorize functions
+func (r *Router) bindFieldAuthorizers() error {
+	for name, t := range r.Schema.TypeMap() {
+		obj, ok := t.(*graphql.Object)
+		if !ok || strings.HasPrefix(name, "__") {
+			continue
+		}
+		for fieldName, def := range obj.Fields() {
+			rs, ok := r.fieldAuthorizeConfig(name, fieldName)
+			if !ok {
+				continue
+			}
+			dri, err := r.getDriver(driver.Config{
+				Provider: rs.Environment.Provider,
+				Runtime:  rs.Environment.Runtime,
+			})
+			if err != nil {
+				return err
+			}
+			def.Resolve = Dispatch{
+				Driver:  r.dispatchDriver(dri, rs.Environment, rs.Policy),
+				TypeMap: &r.Schema,
+			}.FieldAuthorize(*rs.Authorize, def.Resolve)
+		}
+	}
+	return nil
+}
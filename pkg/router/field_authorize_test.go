@@ -0,0 +1,173 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldAuthorize(t *testing.T) {
+	env := router.Environment{
+		Provider: "field",
+		Runtime:  "authorize",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "user"
+	})).Return(driver.FieldResolveOutput{Response: map[string]interface{}{
+		"name":  "John",
+		"email": "john@example.com",
+		"id":    "1",
+	}})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Response: "data"})
+	mockDriver.On("Authorize", mock.MatchedBy(func(in driver.AuthorizeInput) bool {
+		return in.Function.Name == "authorizeUser" && in.Info != nil && in.Info.FieldName == "id"
+	})).Return(driver.AuthorizeOutput{Response: true})
+	mockDriver.On("Authorize", mock.MatchedBy(func(in driver.AuthorizeInput) bool {
+		return in.Function.Name == "authorizeSecret" && in.Arguments["allow"] == true
+	})).Return(driver.AuthorizeOutput{Response: true})
+	mockDriver.On("Authorize", mock.Anything).Return(driver.AuthorizeOutput{Response: false})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.public": {Resolve: types.Function{Name: "public"}},
+			"Query.secret": {
+				Resolve:   types.Function{Name: "secret"},
+				Authorize: &types.Function{Name: "authorizeSecret"},
+			},
+			"Query.user": {Resolve: types.Function{Name: "user"}},
+			"User.*":     {Authorize: &types.Function{Name: "authorizeUser"}},
+		},
+		Schema: `
+type User {
+	id: ID
+	name: String
+	email: String!
+}
+type Query {
+	public: String
+	secret(allow: Boolean): String
+	user: User
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `{ public allowed: secret(allow: true) denied: secret(allow: false) user { id name } nonNull: user { id email } }`,
+		Context:       context.Background(),
+	})
+	assert.Equal(t, map[string]interface{}{
+		"public":  "data",
+		"allowed": "data",
+		"denied":  nil,
+		"user": map[string]interface{}{
+			"id":   "1",
+			"name": nil,
+		},
+		"nonNull": nil,
+	}, res.Data)
+	paths := make([][]interface{}, 0, len(res.Errors))
+	for _, err := range res.Errors {
+		assert.Equal(t, "unauthorized", err.Message)
+		assert.Equal(t, map[string]interface{}{"code": router.Unauthorized}, err.Extensions)
+		paths = append(paths, err.Path)
+	}
+	assert.ElementsMatch(t, [][]interface{}{
+		{"denied"},
+		{"user", "name"},
+		{"nonNull", "email"},
+	}, paths)
+}
+
+func TestAuthorizeClaims(t *testing.T) {
+	env := router.Environment{
+		Provider: "claims",
+		Runtime:  "authorize",
+	}
+	claims := map[string]interface{}{"sub": "user"}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("Authorize", mock.MatchedBy(func(in driver.AuthorizeInput) bool {
+		return in.Function.Name == "authorize"
+	})).Return(driver.AuthorizeOutput{Response: true, Claims: claims})
+	mockDriver.On("Authorize", mock.MatchedBy(func(in driver.AuthorizeInput) bool {
+		return in.Function.Name == "authorizeField" && in.VariableValues["s"] == "parsed" &&
+			assert.ObjectsAreEqual(claims, in.Claims)
+	})).Return(driver.AuthorizeOutput{Response: true})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "echo" && in.Arguments["s"] == "parsed" && assert.ObjectsAreEqual(claims, in.Claims)
+	})).Return(driver.FieldResolveOutput{Response: "echoed"})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return assert.ObjectsAreEqual(claims, in.Claims)
+	})).Return(driver.FieldResolveOutput{Response: "user"})
+	mockDriver.On("ScalarParse", mock.MatchedBy(func(in driver.ScalarParseInput) bool {
+		return in.Value == "raw" && assert.ObjectsAreEqual(claims, in.Claims)
+	})).Return(driver.ScalarParseOutput{Response: "parsed"})
+	mockDriver.On("ScalarSerialize", mock.MatchedBy(func(in driver.ScalarSerializeInput) bool {
+		return in.Value == "echoed" && assert.ObjectsAreEqual(claims, in.Claims)
+	})).Return(driver.ScalarSerializeOutput{Response: "serialized"})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Response: "anonymous"})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Authorize: &router.AuthorizeConfig{
+			Authorize: types.Function{Name: "authorize"},
+		},
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.me": {
+				Resolve:   types.Function{Name: "me"},
+				Authorize: &types.Function{Name: "authorizeField"},
+			},
+			"Query.echo": {Resolve: types.Function{Name: "echo"}},
+		},
+		Scalars: map[string]router.ScalarConfig{
+			"S": {
+				Parse:     types.Function{Name: "parse"},
+				Serialize: types.Function{Name: "serialize"},
+			},
+		},
+		Schema: `
+scalar S
+type Query {
+	me: String
+	echo(s: S): S
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:         rt.Schema,
+		RequestString:  `query($s: S) { me echo(s: $s) literal: echo(s: "raw") }`,
+		VariableValues: map[string]interface{}{"s": "raw"},
+		Context:        context.Background(),
+	})
+	assert.Empty(t, res.Errors)
+	assert.Equal(t, map[string]interface{}{
+		"me":      "user",
+		"echo":    "serialized",
+		"literal": "serialized",
+	}, res.Data)
+}
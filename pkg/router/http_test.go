@@ -0,0 +1,107 @@
+package router_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver/httpdriver"
+	"github.com/graphql-editor/stucco/pkg/driver/mock"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPResolver(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/users/1":
+			rw.Write([]byte(`{"id": "1", "name": "John", "teamId": "2"}`))
+		case "/teams/2":
+			rw.Write([]byte(`{"result": {"name": "Core"}}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	headers := map[string]string{"X-Api-Key": "{secrets.API_KEY}"}
+	rt, err := router.NewRouter(router.Config{
+		Secrets: router.SecretsConfig{Secrets: map[string]string{"API_KEY": "secret"}},
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.user": {
+				Environment: &router.Environment{Provider: httpdriver.Provider},
+				Function: httpdriver.Request{
+					URL:     srv.URL + "/users/{args.id}",
+					Headers: headers,
+				},
+			},
+			"User.team": {
+				Environment: &router.Environment{Provider: httpdriver.Provider},
+				Function: map[interface{}]interface{}{
+					"url":          srv.URL + "/teams/{source.teamId}",
+					"headers":      map[interface{}]interface{}{"X-Api-Key": "{secrets.API_KEY}"},
+					"responsePath": "result",
+				},
+			},
+		},
+		Schema: `
+type Team {
+	name: String
+}
+type User {
+	id: ID
+	name: String
+	team: Team
+}
+type Query {
+	user(id: ID!): User
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `{ user(id: "1") { id name team { name } } missing: user(id: "3") { id } }`,
+		Context:       context.Background(),
+	})
+	assert.Equal(t, map[string]interface{}{
+		"user": map[string]interface{}{
+			"id":   "1",
+			"name": "John",
+			"team": map[string]interface{}{"name": "Core"},
+		},
+		"missing": nil,
+	}, res.Data)
+	require.Len(t, res.Errors, 1)
+	assert.Equal(t, []interface{}{"missing"}, res.Errors[0].Path)
+	assert.Equal(t, http.StatusNotFound, res.Errors[0].Extensions["status"])
+}
+
+func TestFunctionConfigUnsupportedProvider(t *testing.T) {
+	_, err := router.NewRouter(router.Config{
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.user": {
+				Environment: &router.Environment{Provider: mock.Provider},
+				Function:    map[string]interface{}{"url": "http://localhost"},
+			},
+		},
+		Schema: `
+type Query {
+	user: String
+}
+schema {
+	query: Query
+}
+`,
+	})
+	assert.EqualError(t, err, "Query.user: mock provider does not support function config")
+}
@@ -0,0 +1,381 @@
+package router
+
+import (
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/printer"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// IncrementalResult is a part of operation result delivered after initial result
+type IncrementalResult struct {
+	Data   interface{}                `json:"data,omitempty"`
+	Items  []interface{}              `json:"items,omitempty"`
+	Path   []interface{}              `json:"path"`
+	Label  string                     `json:"label,omitempty"`
+	Errors []gqlerrors.FormattedError `json:"errors,omitempty"`
+}
+
+// DeferredOperation is a fragment marked with @defer directive in selection set of operation.
+// It has no fields on path to it, so it is executed as an operation with only fields of fragment.
+type DeferredOperation struct {
+	Label string
+	Query string
+	// Parent is an index of deferred operation enclosing this fragment or -1
+	Parent int
+}
+
+// Result returns incremental result of deferred operation, false is returned if
+// result is empty
+func (d DeferredOperation) Result(result *graphql.Result) (IncrementalResult, bool) {
+	out := IncrementalResult{Path: []interface{}{}, Label: d.Label, Errors: result.Errors}
+	if data, ok := result.Data.(map[string]interface{}); ok && len(data) > 0 {
+		out.Data = data
+	}
+	return out, out.Data != nil || len(out.Errors) > 0
+}
+
+func appendPath(path []interface{}, key interface{}) []interface{} {
+	return append(append(make([]interface{}, 0, len(path)+1), path...), key)
+}
+
+// IncrementalOperation is an operation with fragments marked with @defer split out
+type IncrementalOperation struct {
+	// Query is an operation without deferred fragments. Fragments deferred in selections
+	// of fields are kept skipped and collected with values of fields when operation is
+	// executed in context with Deferred.
+	Query    string
+	Deferred []DeferredOperation
+}
+
+// SplitIncremental splits fragments marked with @defer out of operation. False is returned
+// if operation is invalid or does not use @defer or @stream directives. Deferred fragments
+// of mutations and fragments in lists marked with @stream are not split out. Fragments deferred
+// in selection set of operation are returned as separate operations.
+func SplitIncremental(schema graphql.Schema, query, operationName string, variables map[string]interface{}) (IncrementalOperation, bool) {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{
+		Body: []byte(query),
+		Name: "GraphQL request",
+	})})
+	if err != nil {
+		return IncrementalOperation{}, false
+	}
+	var op *ast.OperationDefinition
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				op = d
+			}
+		case *ast.FragmentDefinition:
+			fragments[d.Name.Value] = d
+		}
+	}
+	if op == nil || op.Operation == ast.OperationTypeSubscription {
+		return IncrementalOperation{}, false
+	}
+	root := schema.QueryType()
+	if op.Operation == ast.OperationTypeMutation {
+		if root = schema.MutationType(); root == nil {
+			return IncrementalOperation{}, false
+		}
+	}
+	selectionSet := inlineFragments(schema, root, op.SelectionSet, fragments)
+	operation := func(ss *ast.SelectionSet) *ast.Document {
+		return ast.NewDocument(&ast.Document{Definitions: []ast.Node{ast.NewOperationDefinition(&ast.OperationDefinition{
+			Operation:           op.Operation,
+			Name:                op.Name,
+			VariableDefinitions: op.VariableDefinitions,
+			Directives:          op.Directives,
+			SelectionSet:        ss,
+		})}})
+	}
+	if res := graphql.ValidateDocument(&schema, operation(selectionSet), nil); !res.IsValid {
+		return IncrementalOperation{}, false
+	}
+	s := incrementalSplitter{
+		variables:  variables,
+		splitDefer: op.Operation == ast.OperationTypeQuery,
+	}
+	s.collect(selectionSet, nil, nil, false, -1)
+	if !s.deferred && !s.streamed {
+		return IncrementalOperation{}, false
+	}
+	inc := IncrementalOperation{
+		Query: printer.Print(operation(s.strip(selectionSet, false, true))).(string),
+	}
+	for _, d := range s.operations {
+		inc.Deferred = append(inc.Deferred, DeferredOperation{
+			Label:  d.label,
+			Query:  printer.Print(operation(s.prune(selectionSet, d.steps))).(string),
+			Parent: d.parent,
+		})
+	}
+	return inc, true
+}
+
+type deferredOperation struct {
+	label  string
+	steps  []int
+	parent int
+}
+
+type incrementalSplitter struct {
+	variables  map[string]interface{}
+	splitDefer bool
+	operations []deferredOperation
+	deferred   bool
+	streamed   bool
+}
+
+// collect finds deferred fragments, steps are indices of selections on path to
+// selection set and path are response keys of fields on that path. Fragments
+// without fields on path are collected as deferred operations.
+func (s *incrementalSplitter) collect(ss *ast.SelectionSet, steps []int, path []string, inStream bool, parent int) {
+	if ss == nil {
+		return
+	}
+	for i, sel := range ss.Selections {
+		selSteps := append(append(make([]int, 0, len(steps)+1), steps...), i)
+		switch v := sel.(type) {
+		case *ast.Field:
+			_, stream := activeDirective(v.Directives, "stream", s.variables)
+			s.streamed = s.streamed || stream
+			key := responseKey(v)
+			s.collect(v.SelectionSet, selSteps, append(append(make([]string, 0, len(path)+1), path...), key), inStream || stream, parent)
+		case *ast.InlineFragment:
+			fragmentParent := parent
+			if args, ok := s.deferredFragment(v, inStream); ok {
+				s.deferred = true
+				if len(path) == 0 {
+					label, _ := args["label"].(string)
+					s.operations = append(s.operations, deferredOperation{
+						label:  label,
+						steps:  selSteps,
+						parent: parent,
+					})
+					fragmentParent = len(s.operations) - 1
+				}
+			}
+			s.collect(v.SelectionSet, selSteps, path, inStream, fragmentParent)
+		}
+	}
+}
+
+func (s *incrementalSplitter) deferredFragment(f *ast.InlineFragment, inStream bool) (map[string]interface{}, bool) {
+	if !s.splitDefer || inStream || excluded(f.Directives, s.variables) {
+		return nil, false
+	}
+	return activeDirective(f.Directives, "defer", s.variables)
+}
+
+// strip returns selection set without deferred fragments. Fragments deferred in
+// selections of fields are kept as markers skipped by executor, fragments deferred
+// in root selection set are removed.
+func (s *incrementalSplitter) strip(ss *ast.SelectionSet, inStream, root bool) *ast.SelectionSet {
+	if ss == nil {
+		return nil
+	}
+	stripped := ast.NewSelectionSet(nil)
+	executed := false
+	for _, sel := range ss.Selections {
+		switch v := sel.(type) {
+		case *ast.Field:
+			_, stream := activeDirective(v.Directives, "stream", s.variables)
+			f := *v
+			f.SelectionSet = s.strip(v.SelectionSet, inStream || stream, false)
+			stripped.Selections = append(stripped.Selections, &f)
+			executed = true
+		case *ast.InlineFragment:
+			// excluded fragments would not be executed anyway, removing them
+			// keeps skipped fragments with @defer unambiguous markers
+			if hasDirective(v.Directives, "defer") && excluded(v.Directives, s.variables) {
+				continue
+			}
+			f := *v
+			if _, ok := s.deferredFragment(v, inStream); ok {
+				if root {
+					continue
+				}
+				f.Directives = append(append([]*ast.Directive{}, v.Directives...), skipDirective())
+			} else {
+				executed = true
+			}
+			f.SelectionSet = s.strip(v.SelectionSet, inStream, root)
+			stripped.Selections = append(stripped.Selections, &f)
+		}
+	}
+	if !executed {
+		stripped.Selections = append(stripped.Selections, typenameField())
+	}
+	return stripped
+}
+
+// prune returns selection set with only inline fragments on path to deferred operation
+// and fields of fragment
+func (s *incrementalSplitter) prune(ss *ast.SelectionSet, steps []int) *ast.SelectionSet {
+	f := *ss.Selections[steps[0]].(*ast.InlineFragment)
+	if len(steps) == 1 {
+		f.Directives = nil
+		f.SelectionSet = s.strip(f.SelectionSet, false, true)
+	} else {
+		f.Directives = withoutDirective(f.Directives, "defer")
+		f.SelectionSet = s.prune(f.SelectionSet, steps[1:])
+	}
+	return ast.NewSelectionSet(&ast.SelectionSet{Selections: []ast.Selection{&f}})
+}
+
+// isDeferredMarker reports whether fragment is deferred fragment skipped in operation
+// to be executed on resolved values
+func isDeferredMarker(f *ast.InlineFragment, variables map[string]interface{}) bool {
+	if _, ok := activeDirective(f.Directives, "defer", variables); !ok {
+		return false
+	}
+	_, skip := activeDirective(f.Directives, "skip", variables)
+	return skip
+}
+
+func skipDirective() *ast.Directive {
+	return ast.NewDirective(&ast.Directive{
+		Name: ast.NewName(&ast.Name{Value: "skip"}),
+		Arguments: []*ast.Argument{ast.NewArgument(&ast.Argument{
+			Name:  ast.NewName(&ast.Name{Value: "if"}),
+			Value: ast.NewBooleanValue(&ast.BooleanValue{Value: true}),
+		})},
+	})
+}
+
+func typenameField() *ast.Field {
+	return ast.NewField(&ast.Field{Name: ast.NewName(&ast.Name{Value: "__typename"})})
+}
+
+func responseKey(f *ast.Field) string {
+	if f.Alias != nil && f.Alias.Value != "" {
+		return f.Alias.Value
+	}
+	return f.Name.Value
+}
+
+// inlineFragments returns copy of selection set with fragment spreads replaced by inline fragments.
+// Inline fragments without type condition are given type of parent as a condition.
+func inlineFragments(schema graphql.Schema, parent graphql.Type, ss *ast.SelectionSet, fragments map[string]*ast.FragmentDefinition) *ast.SelectionSet {
+	if ss == nil {
+		return nil
+	}
+	inline := func(cond *ast.Named, dirs []*ast.Directive, fragment *ast.SelectionSet) *ast.InlineFragment {
+		t := parent
+		if cond != nil {
+			t = schema.Type(cond.Name.Value)
+		} else if parent != nil {
+			cond = ast.NewNamed(&ast.Named{Name: ast.NewName(&ast.Name{Value: parent.Name()})})
+		}
+		return ast.NewInlineFragment(&ast.InlineFragment{
+			TypeCondition: cond,
+			Directives:    dirs,
+			SelectionSet:  inlineFragments(schema, t, fragment, fragments),
+		})
+	}
+	inlined := ast.NewSelectionSet(nil)
+	for _, sel := range ss.Selections {
+		switch v := sel.(type) {
+		case *ast.Field:
+			var t graphql.Type
+			if def := fieldDefinition(parent, v.Name.Value); def != nil {
+				t, _ = graphql.GetNamed(def.Type).(graphql.Type)
+			}
+			f := *v
+			f.SelectionSet = inlineFragments(schema, t, v.SelectionSet, fragments)
+			inlined.Selections = append(inlined.Selections, &f)
+		case *ast.InlineFragment:
+			inlined.Selections = append(inlined.Selections, inline(v.TypeCondition, v.Directives, v.SelectionSet))
+		case *ast.FragmentSpread:
+			if def, ok := fragments[v.Name.Value]; ok {
+				inlined.Selections = append(inlined.Selections, inline(def.TypeCondition, v.Directives, def.SelectionSet))
+			}
+		}
+	}
+	return inlined
+}
+
+func hasDirective(dirs []*ast.Directive, name string) bool {
+	for _, d := range dirs {
+		if d.Name != nil && d.Name.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// excluded reports whether selection is excluded by @skip or @include directive
+func excluded(dirs []*ast.Directive, variables map[string]interface{}) bool {
+	if _, skip := activeDirective(dirs, "skip", variables); skip {
+		return true
+	}
+	if !hasDirective(dirs, "include") {
+		return false
+	}
+	_, include := activeDirective(dirs, "include", variables)
+	return !include
+}
+
+func withoutDirective(dirs []*ast.Directive, name string) []*ast.Directive {
+	var out []*ast.Directive
+	for _, d := range dirs {
+		if d.Name == nil || d.Name.Value != name {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// activeDirective returns arguments of directive unless it is not present
+// or its if argument is false
+func activeDirective(dirs []*ast.Directive, name string, variables map[string]interface{}) (map[string]interface{}, bool) {
+	for _, d := range dirs {
+		if d.Name == nil || d.Name.Value != name {
+			continue
+		}
+		args := map[string]interface{}{}
+		for _, arg := range d.Arguments {
+			args[arg.Name.Value] = astArgumentValue(arg.Value, variables)
+		}
+		if v, ok := args["if"]; ok && v == false {
+			return nil, false
+		}
+		return args, true
+	}
+	return nil, false
+}
+
+func astArgumentValue(v ast.Value, variables map[string]interface{}) interface{} {
+	switch vv := v.(type) {
+	case *ast.Variable:
+		return variables[vv.Name.Value]
+	case *ast.BooleanValue:
+		return vv.Value
+	case *ast.StringValue:
+		return vv.Value
+	case *ast.IntValue:
+		i, _ := strconv.Atoi(vv.Value)
+		return i
+	}
+	return nil
+}
+
+// directiveIntArgument returns int argument of directive
+func directiveIntArgument(args map[string]interface{}, name string) int {
+	switch v := args[name].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return 0
+}
@@ -0,0 +1,214 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/parser"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	gqlparser "github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/printer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const incrementalSchema = `
+type User {
+	id: ID
+	name: String
+	friends: [User]
+}
+
+type Query {
+	user: User
+	users: [User]
+}
+
+type Mutation {
+	createUser: User
+}
+
+schema {
+	query: Query
+	mutation: Mutation
+}
+`
+
+func printQuery(t *testing.T, query string) string {
+	doc, err := gqlparser.Parse(gqlparser.ParseParams{Source: query})
+	require.NoError(t, err)
+	return printer.Print(doc).(string)
+}
+
+func TestSplitIncremental(t *testing.T) {
+	p := parser.NewParser(parser.Config{})
+	schema, err := p.Parse(incrementalSchema)
+	require.NoError(t, err)
+	data := []struct {
+		title         string
+		query         string
+		variables     map[string]interface{}
+		split         bool
+		expectedQuery string
+		deferred      []string
+		parents       []int
+		labels        []string
+	}{
+		{
+			title: "NotIncremental",
+			query: `{ user { id } }`,
+		},
+		{
+			title: "InvalidQuery",
+			query: `{ user { unknown ...@defer { id } } }`,
+		},
+		{
+			title:         "SkipsDeferredFragments",
+			query:         `{ user { id ...@defer(label: "name") { name } } users { ...UserName @defer } } fragment UserName on User { name }`,
+			split:         true,
+			expectedQuery: `{ user { id ... on User @defer(label: "name") @skip(if: true) { name } } users { ... on User @defer @skip(if: true) { name } __typename } }`,
+		},
+		{
+			title:         "SkipsNestedDeferredFragments",
+			query:         `{ user { ...@defer { name friends { id ...@defer { name } } } } }`,
+			split:         true,
+			expectedQuery: `{ user { ... on User @defer @skip(if: true) { name friends { id ... on User @defer @skip(if: true) { name } } } __typename } }`,
+		},
+		{
+			title:         "SplitsRootDeferredFragments",
+			query:         `{ user { id } ...@defer(label: "root") { users { name ...@defer { id } } ...@defer { user { name } } } }`,
+			split:         true,
+			expectedQuery: `{ user { id } }`,
+			deferred: []string{
+				`{ ... on Query { users { name ... on User @defer @skip(if: true) { id } } } }`,
+				`{ ... on Query { ... on Query { user { name } } } }`,
+			},
+			parents: []int{-1, 0},
+			labels:  []string{"root", ""},
+		},
+		{
+			title:         "DropsExcludedDeferredFragments",
+			query:         `query($skip: Boolean!) { user { id ...@defer @skip(if: $skip) { name } } users @stream { id } }`,
+			variables:     map[string]interface{}{"skip": true},
+			split:         true,
+			expectedQuery: `query($skip: Boolean!) { user { id } users @stream { id } }`,
+		},
+		{
+			title:         "IgnoresDisabledDefer",
+			query:         `query($defer: Boolean) { user { id ...@defer(if: $defer) { name } } users @stream { id } }`,
+			variables:     map[string]interface{}{"defer": false},
+			split:         true,
+			expectedQuery: `query($defer: Boolean) { user { id ... on User @defer(if: $defer) { name } } users @stream { id } }`,
+		},
+		{
+			title:         "DoesNotSplitFragmentsInStreamedLists",
+			query:         `{ users @stream(initialCount: 1) { id ...@defer { name } } }`,
+			split:         true,
+			expectedQuery: `{ users @stream(initialCount: 1) { id ... on User @defer { name } } }`,
+		},
+		{
+			title:         "DoesNotSplitMutations",
+			query:         `mutation { createUser { id ...@defer { name } friends @stream { id } } }`,
+			split:         true,
+			expectedQuery: `mutation { createUser { id ... on User @defer { name } friends @stream { id } } }`,
+		},
+	}
+	for i := range data {
+		tt := data[i]
+		t.Run(tt.title, func(t *testing.T) {
+			op, ok := router.SplitIncremental(schema, tt.query, "", tt.variables)
+			require.Equal(t, tt.split, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, printQuery(t, tt.expectedQuery), op.Query)
+			require.Len(t, op.Deferred, len(tt.deferred))
+			for i, d := range op.Deferred {
+				assert.Equal(t, printQuery(t, tt.deferred[i]), d.Query)
+				assert.Equal(t, tt.parents[i], d.Parent)
+				assert.Equal(t, tt.labels[i], d.Label)
+			}
+		})
+	}
+}
+
+func TestDeferredFragmentExecute(t *testing.T) {
+	env := router.Environment{
+		Provider: "deferred",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "users"
+	})).Return(driver.FieldResolveOutput{Response: []interface{}{
+		map[string]interface{}{"id": "1", "name": "a", "friends": []interface{}{map[string]interface{}{"name": "c"}}},
+		map[string]interface{}{"id": "2", "name": "b"},
+	}})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.users": {
+				Resolve: types.Function{Name: "users"},
+			},
+		},
+		Schema: incrementalSchema,
+	})
+	require.NoError(t, err)
+	op, ok := router.SplitIncremental(rt.Schema, `{ users { id ...@defer(label: "name") { name ...@defer { friends { name } } } } }`, "", nil)
+	require.True(t, ok)
+	deferred := router.NewDeferred()
+	result := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: op.Query,
+		Context:       router.WithDeferred(context.Background(), deferred),
+	})
+	require.Empty(t, result.Errors)
+	assert.Equal(t, map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{"id": "1"},
+			map[string]interface{}{"id": "2"},
+		},
+	}, result.Data)
+	fragments := deferred.Take(result.Data)
+	require.Len(t, fragments, 2)
+	var results []router.IncrementalResult
+	var nested []*router.DeferredFragment
+	for _, f := range fragments {
+		r, children := f.Execute(context.Background(), router.NewStreams(context.Background()))
+		results = append(results, r)
+		nested = append(nested, children...)
+	}
+	assert.Equal(t, []router.IncrementalResult{
+		{
+			Data:  map[string]interface{}{"name": "a"},
+			Path:  []interface{}{"users", 0},
+			Label: "name",
+		},
+		{
+			Data:  map[string]interface{}{"name": "b"},
+			Path:  []interface{}{"users", 1},
+			Label: "name",
+		},
+	}, results)
+	require.Len(t, nested, 2)
+	r, children := nested[0].Execute(context.Background(), router.NewStreams(context.Background()))
+	assert.Empty(t, children)
+	assert.Equal(t, router.IncrementalResult{
+		Data: map[string]interface{}{
+			"friends": []interface{}{map[string]interface{}{"name": "c"}},
+		},
+		Path: []interface{}{"users", 0},
+	}, r)
+	// fields on path to deferred fragments are not resolved again
+	mockDriver.AssertNumberOfCalls(t, "FieldResolve", 1)
+}
@@ -0,0 +1,107 @@
+package router
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/mock"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-editor/stucco/pkg/utils"
+	"github.com/graphql-go/graphql"
+)
+
+// mockDriver returns mock driver synthesizing values from router schema
+func (r *Router) mockDriver() (*mock.Driver, error) {
+	dri, err := r.getDriver(driver.Config{Provider: mock.Provider})
+	if err != nil {
+		return nil, err
+	}
+	md, ok := dri.(*mock.Driver)
+	if !ok {
+		return nil, fmt.Errorf("%s provider is not a mock driver", mock.Provider)
+	}
+	return md, nil
+}
+
+// configureMock sets up mock driver returned from registry with router
+// schema and mock config. First configured driver is used for mocked objects.
+func (r *Router) configureMock(md *mock.Driver) {
+	md.Schema = &r.Schema
+	md.Fixtures = r.mockFixtures
+	if r.mockConfig != nil {
+		md.Seed = r.mockConfig.Seed
+	}
+	if r.mock == nil {
+		r.mock = md
+	}
+}
+
+func (r *Router) loadMock(c Config) error {
+	r.mockConfig = c.Mock
+	r.mockMissing = c.Environment.Provider == mock.Provider || (c.Mock != nil && c.Mock.Missing)
+	if c.Mock != nil && c.Mock.Fixtures != "" {
+		if err := utils.LoadConfigFile(c.Mock.Fixtures, &r.mockFixtures); err != nil {
+			return err
+		}
+	}
+	if r.mockMissing {
+		_, err := r.mockDriver()
+		return err
+	}
+	return nil
+}
+
+// hasResolver returns true if field has a resolver defined in config
+func (r *Router) hasResolver(field string) bool {
+	rs, ok := r.Resolvers[field]
+	return ok && rs.resolves()
+}
+
+// bindMockResolvers resolves fields of mocked objects and, in missing mode,
+// fields without resolver with mock driver
+func (r *Router) bindMockResolvers() {
+	if r.mock == nil {
+		return
+	}
+	for name, t := range r.Schema.TypeMap() {
+		obj, ok := t.(*graphql.Object)
+		if !ok || strings.HasPrefix(name, "__") {
+			continue
+		}
+		for fieldName, field := range obj.Fields() {
+			if strings.HasPrefix(fieldName, "__") || r.hasResolver(name+"."+fieldName) {
+				continue
+			}
+			field.Resolve = r.mockFieldResolve(name+"."+fieldName, field.Resolve)
+		}
+	}
+}
+
+func (r *Router) mockFieldResolve(field string, resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	if resolve == nil {
+		resolve = graphql.DefaultResolveFn
+	}
+	mockResolve := Dispatch{
+		Driver:   r.dispatchDriver(r.mock, nil, nil),
+		TypeMap:  &r.Schema,
+		MaxDepth: r.MaxDepth,
+	}.FieldResolve(ResolverConfig{
+		Resolve: types.Function{Name: field},
+	})
+	fieldName := field[strings.LastIndex(field, ".")+1:]
+	missing := r.mockMissing
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		if obj, ok := params.Source.(mock.Object); ok {
+			if v, ok := obj[fieldName]; ok {
+				return v, nil
+			}
+			return mockResolve(params)
+		}
+		v, err := resolve(params)
+		if err != nil || v != nil || !missing {
+			return v, err
+		}
+		return mockResolve(params)
+	}
+}
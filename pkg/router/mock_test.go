@@ -0,0 +1,113 @@
+package router_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockMissing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mock")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fixtures := filepath.Join(dir, "fixtures.json")
+	require.NoError(t, ioutil.WriteFile(fixtures, []byte(`{"User.name": "John"}`), 0644))
+	env := router.Environment{
+		Provider: "mock-missing",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Response: "real"})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.real": {Resolve: types.Function{Name: "real"}},
+		},
+		Mock: &router.MockConfig{
+			Fixtures: fixtures,
+			Seed:     1,
+			Missing:  true,
+		},
+		Schema: `
+type User {
+	name: String
+	friend: User!
+}
+type Query {
+	real: String
+	user: User
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	do := func() *graphql.Result {
+		return graphql.Do(graphql.Params{
+			Schema:        rt.Schema,
+			RequestString: `{ real user { name friend { name friend { name } } } }`,
+			Context:       context.Background(),
+		})
+	}
+	res := do()
+	require.Empty(t, res.Errors)
+	assert.Equal(t, map[string]interface{}{
+		"real": "real",
+		"user": map[string]interface{}{
+			"name": "John",
+			"friend": map[string]interface{}{
+				"name":   "John",
+				"friend": map[string]interface{}{"name": "John"},
+			},
+		},
+	}, res.Data)
+	assert.Equal(t, res.Data, do().Data)
+}
+
+func TestMockProvider(t *testing.T) {
+	rt, err := router.NewRouter(router.Config{
+		Environment: router.Environment{Provider: "mock"},
+		Schema: `
+type User {
+	id: ID!
+	email: String!
+}
+type Query {
+	users: [User!]!
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `{ users { id email } }`,
+		Context:       context.Background(),
+	})
+	require.Empty(t, res.Errors)
+	users := res.Data.(map[string]interface{})["users"].([]interface{})
+	require.NotEmpty(t, users)
+	for _, u := range users {
+		assert.NotEmpty(t, u.(map[string]interface{})["id"])
+		assert.Contains(t, u.(map[string]interface{})["email"], "@example.com")
+	}
+}
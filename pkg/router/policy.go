@@ -0,0 +1,159 @@
+package router
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+)
+
+const (
+	defaultRetryInitialInterval = 100 * time.Millisecond
+	defaultRetryMaxInterval     = 10 * time.Second
+	defaultRetryMultiplier      = 2
+)
+
+// Error classes that can be retried
+const (
+	RetryOnTimeout     = "timeout"
+	RetryOnUnavailable = "unavailable"
+	RetryOnError       = "error"
+)
+
+var (
+	jitterRandMu sync.Mutex
+	jitterRand   = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+func jitter(d time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return d
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	jitterRandMu.Lock()
+	r := jitterRand.Float64()
+	jitterRandMu.Unlock()
+	return d - time.Duration(float64(d)*fraction*r)
+}
+
+// callPolicy applies timeout and retry policy to calls of driver
+type callPolicy struct {
+	policy PolicyConfig
+}
+
+// withPolicy returns a driver that applies policy to all calls made during
+// a request. Subscription and stream calls are not affected.
+func withPolicy(dri driver.Driver, policy *PolicyConfig) driver.Driver {
+	if policy == nil || (policy.Timeout <= 0 && policy.Retry == nil) {
+		return dri
+	}
+	return wrapDriver(dri, callPolicy{
+		policy: *policy,
+	}.do)
+}
+
+func (p callPolicy) maxAttempts() int {
+	if p.policy.Retry == nil || p.policy.Retry.MaxAttempts < 1 {
+		return 1
+	}
+	return p.policy.Retry.MaxAttempts
+}
+
+func (p callPolicy) retryable(err *driver.Error) bool {
+	retryOn := p.policy.Retry.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = []string{RetryOnTimeout, RetryOnUnavailable}
+	}
+	for _, class := range retryOn {
+		switch {
+		case class == RetryOnError,
+			class == RetryOnTimeout && err.Code == driver.ErrorCodeTimeout,
+			class == RetryOnUnavailable && err.Code == driver.ErrorCodeUnavailable:
+			return true
+		}
+	}
+	return false
+}
+
+func (p callPolicy) backoff(attempt int) time.Duration {
+	retry := p.policy.Retry
+	interval := time.Duration(retry.InitialInterval)
+	if interval <= 0 {
+		interval = defaultRetryInitialInterval
+	}
+	maxInterval := time.Duration(retry.MaxInterval)
+	if maxInterval <= 0 {
+		maxInterval = defaultRetryMaxInterval
+	}
+	multiplier := retry.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultRetryMultiplier
+	}
+	for i := 1; i < attempt && interval < maxInterval; i++ {
+		interval = time.Duration(float64(interval) * multiplier)
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	return jitter(interval, retry.Jitter)
+}
+
+type policyResult struct {
+	v   interface{}
+	err *driver.Error
+}
+
+// attempt calls f once, abandoning it if it does not finish before timeout
+func (p callPolicy) attempt(ctx context.Context, f callFunc) (interface{}, *driver.Error) {
+	if p.policy.Timeout <= 0 {
+		return f(ctx)
+	}
+	actx, cancel := context.WithTimeout(ctx, time.Duration(p.policy.Timeout))
+	defer cancel()
+	out := make(chan policyResult, 1)
+	go func() {
+		v, err := f(actx)
+		out <- policyResult{v: v, err: err}
+	}()
+	select {
+	case r := <-out:
+		if r.err != nil && r.err.Code == "" && ctx.Err() == nil && actx.Err() == context.DeadlineExceeded {
+			derr := *r.err
+			derr.Code = driver.ErrorCodeTimeout
+			r.err = &derr
+		}
+		return r.v, r.err
+	case <-actx.Done():
+		if ctx.Err() != nil {
+			return nil, &driver.Error{Message: ctx.Err().Error()}
+		}
+		return nil, &driver.Error{
+			Message: "function call timed out after " + time.Duration(p.policy.Timeout).String(),
+			Code:    driver.ErrorCodeTimeout,
+		}
+	}
+}
+
+// do calls f until it succeeds, returns an error that cannot be retried or
+// runs out of attempts
+func (p callPolicy) do(ctx context.Context, fn types.Function, f callFunc) (interface{}, *driver.Error) {
+	maxAttempts := p.maxAttempts()
+	for attempt := 1; ; attempt++ {
+		v, err := p.attempt(ctx, f)
+		if err == nil || attempt >= maxAttempts || ctx.Err() != nil || !p.retryable(err) {
+			return v, err
+		}
+		t := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return v, err
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package router_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDurationUnmarshalJSON(t *testing.T) {
+	var d struct {
+		A router.Duration `json:"a"`
+		B router.Duration `json:"b"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"a": 1.5, "b": "200ms"}`), &d))
+	assert.Equal(t, router.Duration(1500*time.Millisecond), d.A)
+	assert.Equal(t, router.Duration(200*time.Millisecond), d.B)
+	assert.Error(t, json.Unmarshal([]byte(`{"a": true}`), &d))
+}
+
+func policyRouter(t *testing.T, env router.Environment, mockDriver *drivertest.MockDriver, policy router.PolicyConfig) router.Router {
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.field": {
+				Resolve: types.Function{Name: "field"},
+				Policy:  &policy,
+			},
+		},
+		Schema: `
+type Query {
+	field: String
+}
+schema {
+	query: Query
+}
+`,
+	})
+	assert.NoError(t, err)
+	return rt
+}
+
+func TestPolicyRetry(t *testing.T) {
+	data := []struct {
+		title         string
+		policy        router.PolicyConfig
+		errors        []*driver.Error
+		expectedData  interface{}
+		expectedCalls int
+	}{
+		{
+			title: "RetriesUnavailable",
+			policy: router.PolicyConfig{
+				Retry: &router.RetryConfig{
+					MaxAttempts:     3,
+					InitialInterval: router.Duration(time.Millisecond),
+				},
+			},
+			errors: []*driver.Error{
+				{Message: "unavailable", Code: driver.ErrorCodeUnavailable},
+				{Message: "unavailable", Code: driver.ErrorCodeUnavailable},
+			},
+			expectedData:  map[string]interface{}{"field": "data"},
+			expectedCalls: 3,
+		},
+		{
+			title: "DoesNotRetryFunctionError",
+			policy: router.PolicyConfig{
+				Retry: &router.RetryConfig{
+					MaxAttempts:     3,
+					InitialInterval: router.Duration(time.Millisecond),
+				},
+			},
+			errors: []*driver.Error{
+				{Message: "error"},
+			},
+			expectedData:  map[string]interface{}{"field": nil},
+			expectedCalls: 1,
+		},
+		{
+			title: "RetriesAnyError",
+			policy: router.PolicyConfig{
+				Retry: &router.RetryConfig{
+					MaxAttempts:     2,
+					RetryOn:         []string{router.RetryOnError},
+					InitialInterval: router.Duration(time.Millisecond),
+					Jitter:          0.5,
+				},
+			},
+			errors: []*driver.Error{
+				{Message: "error"},
+			},
+			expectedData:  map[string]interface{}{"field": "data"},
+			expectedCalls: 2,
+		},
+		{
+			title: "StopsAfterMaxAttempts",
+			policy: router.PolicyConfig{
+				Retry: &router.RetryConfig{
+					MaxAttempts:     2,
+					InitialInterval: router.Duration(time.Millisecond),
+				},
+			},
+			errors: []*driver.Error{
+				{Message: "unavailable", Code: driver.ErrorCodeUnavailable},
+				{Message: "unavailable", Code: driver.ErrorCodeUnavailable},
+			},
+			expectedData:  map[string]interface{}{"field": nil},
+			expectedCalls: 2,
+		},
+	}
+	for i := range data {
+		tt := data[i]
+		t.Run(tt.title, func(t *testing.T) {
+			mockDriver := new(drivertest.MockDriver)
+			for _, err := range tt.errors {
+				mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Error: err}).Once()
+			}
+			mockDriver.On("FieldResolve", mock.Anything).Return(driver.FieldResolveOutput{Response: "data"})
+			rt := policyRouter(t, router.Environment{
+				Provider: "policy",
+				Runtime:  tt.title,
+			}, mockDriver, tt.policy)
+			res := graphql.Do(graphql.Params{
+				Schema:        rt.Schema,
+				RequestString: "{ field }",
+				Context:       context.Background(),
+			})
+			assert.Equal(t, tt.expectedData, res.Data)
+			mockDriver.AssertNumberOfCalls(t, "FieldResolve", tt.expectedCalls)
+		})
+	}
+}
+
+func TestPolicyTimeout(t *testing.T) {
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("FieldResolve", mock.Anything).Run(func(mock.Arguments) {
+		time.Sleep(50 * time.Millisecond)
+	}).Return(driver.FieldResolveOutput{Response: "data"})
+	rt := policyRouter(t, router.Environment{
+		Provider: "policy",
+		Runtime:  "timeout",
+	}, mockDriver, router.PolicyConfig{
+		Timeout: router.Duration(time.Millisecond),
+	})
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: "{ field }",
+		Context:       context.Background(),
+	})
+	assert.Equal(t, map[string]interface{}{"field": nil}, res.Data)
+	if assert.Len(t, res.Errors, 1) {
+		assert.Contains(t, res.Errors[0].Message, "timed out")
+	}
+}
@@ -0,0 +1,97 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/graphql-editor/stucco/pkg/driver/httpdriver"
+	"github.com/graphql-go/graphql"
+)
+
+// subscriptionTopic returns name of topic with arguments of subscription field interpolated
+func subscriptionTopic(topic string, args map[string]interface{}) (string, error) {
+	t, err := httpdriver.ParseTemplate(topic)
+	if err != nil {
+		return "", err
+	}
+	return t.Execute(map[string]interface{}{"args": args}), nil
+}
+
+// bindSubscriptionTopic checks topic of subscription field and binds resolver
+// of field that resolves to published payload unless field has one
+func (r *Router) bindSubscriptionTopic(field string, cfg SubscriptionConfig) error {
+	if cfg.Kind != DefaultSubscription && cfg.Kind != BlockingSubscription {
+		return fmt.Errorf("subscription %s: topic is supported only by blocking subscriptions", field)
+	}
+	if _, err := httpdriver.ParseTemplate(cfg.Topic); err != nil {
+		return fmt.Errorf("subscription %s: %w", field, err)
+	}
+	subscription := r.Schema.SubscriptionType()
+	f, ok := subscription.Fields()[field]
+	if !ok {
+		return fmt.Errorf("subscription %s is not defined", field)
+	}
+	if rs, ok := r.Resolvers[subscription.Name()+"."+field]; ok && rs.resolves() {
+		return nil
+	}
+	f.Resolve = topicFieldResolve
+	return nil
+}
+
+// topicFieldResolve records arguments of subscription field and resolves field
+// to payload published to topic
+func topicFieldResolve(params graphql.ResolveParams) (interface{}, error) {
+	if err := getRouterError(params.Context); err != nil {
+		return nil, err
+	}
+	if subCtx, ok := params.Context.Value(subscriptionExtensionKey).(*SubscribeContext); ok && subCtx.IsSubscription {
+		subCtx.arguments = params.Args
+		return nil, nil
+	}
+	return params.Context.Value(SubscriptionPayloadKey), nil
+}
+
+// argumentsFieldResolver resolves field to its arguments
+func argumentsFieldResolver(params graphql.ResolveParams) (interface{}, error) {
+	if err := getRouterError(params.Context); err != nil {
+		return nil, err
+	}
+	return params.Args, nil
+}
+
+// publishFieldResolver returns resolver that publishes value resolved by resolve
+// to topic of pub/sub broker
+func (r *Router) publishFieldResolver(resolve graphql.FieldResolveFn, cfg PublishConfig) (graphql.FieldResolveFn, error) {
+	if cfg.Topic == "" {
+		return nil, errors.New("publish topic is required")
+	}
+	t, err := httpdriver.ParseTemplate(cfg.Topic)
+	if err != nil {
+		return nil, err
+	}
+	broker := r.Broker
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		if err := getRouterError(params.Context); err != nil {
+			return nil, err
+		}
+		v, err := resolve(params)
+		if err != nil {
+			return v, err
+		}
+		topic := t.Execute(map[string]interface{}{
+			"args":   params.Args,
+			"source": params.Source,
+		})
+		if thunk, ok := v.(func() (interface{}, error)); ok {
+			return func() (interface{}, error) {
+				v, err := thunk()
+				if err == nil {
+					broker.Publish(topic, v)
+				}
+				return v, err
+			}, nil
+		}
+		broker.Publish(topic, v)
+		return v, nil
+	}, nil
+}
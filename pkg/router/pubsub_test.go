@@ -0,0 +1,143 @@
+package router_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/pubsub"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPubSubSubscription(t *testing.T) {
+	env := router.Environment{
+		Provider: "pubsub",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	broker := pubsub.New()
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Broker:      broker,
+		Resolvers: map[string]router.ResolverConfig{
+			"Mutation.sendMessage": {Publish: &router.PublishConfig{Topic: "room.{args.room}"}},
+		},
+		SubscriptionConfigs: map[string]router.SubscriptionConfig{
+			"messages": {Topic: "room.{args.room}"},
+		},
+		Schema: `type Message {
+			room: String
+			text: String
+		}
+		type Query {
+			field: String
+		}
+		type Mutation {
+			sendMessage(room: String!, text: String!): Message
+		}
+		type Subscription {
+			messages(room: String!): Message
+		}
+		schema {
+			query: Query
+			mutation: Mutation
+			subscription: Subscription
+		}`,
+	})
+	require.NoError(t, err)
+	assert.Same(t, broker, rt.Broker)
+	subscription := `subscription { messages(room: "a") { text } }`
+	res := graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: subscription,
+		Context:       context.Background(),
+	})
+	require.Empty(t, res.Errors)
+	sub, ok := res.Extensions["subscriptionBlocking"].(router.BlockingSubscriptionPayload)
+	require.True(t, ok)
+	defer sub.Reader.Close()
+	published := make(chan *graphql.Result)
+	go func() {
+		published <- graphql.Do(graphql.Params{
+			Schema:        rt.Schema,
+			RequestString: `mutation { sendMessage(room: "a", text: "hello") { room text } }`,
+			Context:       context.Background(),
+		})
+	}()
+	require.True(t, sub.Reader.Next())
+	payload, err := sub.Reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"sendMessage": map[string]interface{}{"room": "a", "text": "hello"},
+	}, (<-published).Data)
+	ctx := context.WithValue(context.Background(), router.RawSubscriptionKey, true)
+	ctx = context.WithValue(ctx, router.SubscriptionPayloadKey, payload)
+	res = graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: subscription,
+		Context:       ctx,
+	})
+	assert.Equal(t, map[string]interface{}{
+		"messages": map[string]interface{}{"text": "hello"},
+	}, res.Data)
+}
+
+func TestPublishRouterError(t *testing.T) {
+	env := router.Environment{
+		Provider: "pubsub",
+		Runtime:  "error",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	broker := pubsub.New()
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Broker:      broker,
+		Resolvers: map[string]router.ResolverConfig{
+			"Mutation.send": {Publish: &router.PublishConfig{Topic: "messages"}},
+		},
+		Schema: `type Message {
+			text: String
+		}
+		type Query {
+			field: String
+		}
+		type Mutation {
+			send(text: String!): Message
+		}
+		schema {
+			query: Query
+			mutation: Mutation
+		}`,
+	})
+	require.NoError(t, err)
+	sub := broker.Subscribe("messages")
+	defer sub.Close()
+	denied := errors.New("denied")
+	_, err = rt.Schema.MutationType().Fields()["send"].Resolve(graphql.ResolveParams{
+		Args:    map[string]interface{}{"text": "x"},
+		Context: context.WithValue(context.Background(), router.ContextKey, &router.Context{Error: denied}),
+	})
+	assert.Equal(t, denied, err)
+	// first message received by subscriber is published after rejected field
+	broker.Publish("messages", "after")
+	require.True(t, sub.Next())
+	payload, err := sub.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "after", payload)
+}
@@ -0,0 +1,85 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// remoteTypesTimeout bounds time in which remote schemas must be fetched when router is created
+const remoteTypesTimeout = 30 * time.Second
+
+// remoteTypesDriver is implemented by drivers of functions which can merge types
+// of their remote schemas into local schema
+type remoteTypesDriver interface {
+	RemoteTypes(ctx context.Context, function string) (map[string]string, error)
+}
+
+// mergeRemoteTypes adds types of remote schemas of resolvers which functions merge
+// types to source. Types defined in source are not replaced.
+func (r *Router) mergeRemoteTypes(source string) (string, error) {
+	var fields []string
+	for k, rs := range r.Resolvers {
+		if rs.Function != nil {
+			fields = append(fields, k)
+		}
+	}
+	if len(fields) == 0 {
+		return source, nil
+	}
+	sort.Strings(fields)
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTypesTimeout)
+	defer cancel()
+	var doc *ast.Document
+	defined := map[string]bool{}
+	var b strings.Builder
+	b.WriteString(source)
+	for _, k := range fields {
+		rs := r.Resolvers[k]
+		dri, err := r.resolverDriver(k, &rs)
+		if err != nil {
+			return "", err
+		}
+		remote, ok := dri.(remoteTypesDriver)
+		if !ok {
+			continue
+		}
+		definitions, err := remote.RemoteTypes(ctx, rs.Resolve.Name)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", k, err)
+		}
+		if len(definitions) == 0 {
+			continue
+		}
+		if doc == nil {
+			if doc, err = parser.Parse(parser.ParseParams{Source: source}); err != nil {
+				return "", err
+			}
+			for _, def := range doc.Definitions {
+				if t, ok := def.(ast.TypeSystemDefinition); ok {
+					if named, ok := t.(interface{ GetName() *ast.Name }); ok && named.GetName() != nil {
+						defined[named.GetName().Value] = true
+					}
+				}
+			}
+		}
+		names := make([]string, 0, len(definitions))
+		for name := range definitions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if defined[name] {
+				continue
+			}
+			defined[name] = true
+			b.WriteString("\n" + definitions[name] + "\n")
+		}
+	}
+	return b.String(), nil
+}
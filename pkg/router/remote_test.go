@@ -0,0 +1,166 @@
+package router_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver/graphqldriver"
+	"github.com/graphql-editor/stucco/pkg/parser"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRemoteGraphQL(t *testing.T, queries *[]string) *httptest.Server {
+	users := map[string]map[string]interface{}{
+		"1": {"id": "1", "name": "John", "role": "ADMIN", "friends": []string{"2"}},
+		"2": {"id": "2", "name": "Jane", "role": "USER", "friends": []string{"1"}},
+	}
+	p := parser.NewParser(parser.Config{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.user": func(p graphql.ResolveParams) (interface{}, error) {
+				return users[p.Args["id"].(string)], nil
+			},
+			"User.nickname": func(p graphql.ResolveParams) (interface{}, error) {
+				return nil, errors.New("nickname is not set")
+			},
+			"User.friends": func(p graphql.ResolveParams) (interface{}, error) {
+				if p.Args["first"].(int) < 0 {
+					return nil, errors.New("first must not be negative")
+				}
+				var friends []interface{}
+				for _, id := range p.Source.(map[string]interface{})["friends"].([]string) {
+					friends = append(friends, users[id])
+				}
+				return friends, nil
+			},
+		},
+	})
+	schema, err := p.Parse(`
+enum Role {
+	ADMIN
+	USER
+}
+type User {
+	id: ID!
+	name: String
+	nickname: String
+	role: Role
+	friends(first: Int = 10): [User!]!
+}
+type Query {
+	user(id: ID!): User
+}
+schema {
+	query: Query
+}
+`)
+	require.NoError(t, err)
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token", r.Header.Get("Authorization"))
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*queries = append(*queries, body.Query)
+		json.NewEncoder(rw).Encode(graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  body.Query,
+			VariableValues: body.Variables,
+		}))
+	}))
+}
+
+func TestGraphQLResolver(t *testing.T) {
+	var queries []string
+	srv := newRemoteGraphQL(t, &queries)
+	defer srv.Close()
+	rt, err := router.NewRouter(router.Config{
+		Secrets: router.SecretsConfig{Secrets: map[string]string{"TOKEN": "token"}},
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.member": {
+				Environment: &router.Environment{Provider: graphqldriver.Provider},
+				Function: graphqldriver.Request{
+					URL:        srv.URL,
+					Headers:    map[string]string{"Authorization": "{secrets.TOKEN}"},
+					Field:      "user",
+					MergeTypes: true,
+				},
+			},
+		},
+		Schema: `
+type Query {
+	member(id: ID!): User
+}
+schema {
+	query: Query
+}
+`,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rt.Schema.Type("Role"))
+
+	res := graphql.Do(graphql.Params{
+		Schema: rt.Schema,
+		RequestString: `query Member($id: ID!, $first: Int) {
+			me: member(id: $id) {
+				name
+				role
+				friends(first: $first) { ... on User { id } }
+			}
+		}`,
+		VariableValues: map[string]interface{}{"id": "1", "first": 1},
+		Context:        context.Background(),
+	})
+	require.Empty(t, res.Errors)
+	assert.Equal(t, map[string]interface{}{
+		"me": map[string]interface{}{
+			"name":    "John",
+			"role":    "ADMIN",
+			"friends": []interface{}{map[string]interface{}{"id": "2"}},
+		},
+	}, res.Data)
+	assert.Equal(
+		t,
+		`query($id: ID!, $first: Int) { me: user(id: $id) { __typename name role friends(first: $first) { __typename ... on User { __typename id } } } }`,
+		queries[len(queries)-1],
+	)
+
+	res = graphql.Do(graphql.Params{
+		Schema:        rt.Schema,
+		RequestString: `{ member(id: "1") { name friends(first: -1) { id } } }`,
+		Context:       context.Background(),
+	})
+	assert.Equal(t, map[string]interface{}{"member": nil}, res.Data)
+	require.Len(t, res.Errors, 1)
+	assert.Equal(t, "first must not be negative", res.Errors[0].Message)
+	assert.Equal(t, []interface{}{"member", "friends"}, res.Errors[0].Path)
+
+	res = graphql.Do(graphql.Params{
+		Schema: rt.Schema,
+		RequestString: `{ member(id: "1") {
+			name
+			nickname
+			first: friends(first: 1) { id }
+			all: friends(first: 5) { name }
+		} }`,
+		Context: context.Background(),
+	})
+	assert.Equal(t, map[string]interface{}{
+		"member": map[string]interface{}{
+			"name":     "John",
+			"nickname": nil,
+			"first":    []interface{}{map[string]interface{}{"id": "2"}},
+			"all":      []interface{}{map[string]interface{}{"name": "Jane"}},
+		},
+	}, res.Data)
+	require.Len(t, res.Errors, 1)
+	assert.Equal(t, "nickname is not set", res.Errors[0].Message)
+	assert.Equal(t, []interface{}{"member", "nickname"}, res.Errors[0].Path)
+}
@@ -0,0 +1,87 @@
+package router
+
+import (
+	"strings"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-go/graphql"
+)
+
+// bindResponseObjects resolves fields without resolvers of driver.ResponseObject
+// values by response keys of fields
+func (r *Router) bindResponseObjects() {
+	for name, t := range r.Schema.TypeMap() {
+		obj, ok := t.(*graphql.Object)
+		if !ok || strings.HasPrefix(name, "__") {
+			continue
+		}
+		for fieldName, field := range obj.Fields() {
+			if strings.HasPrefix(fieldName, "__") || r.hasResolver(name+"."+fieldName) {
+				continue
+			}
+			field.Resolve = responseObjectResolve(field.Resolve)
+		}
+	}
+}
+
+func responseObjectResolve(resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	if resolve == nil {
+		resolve = graphql.DefaultResolveFn
+	}
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		obj, ok := params.Source.(driver.ResponseObject)
+		if !ok {
+			return resolve(params)
+		}
+		key, _ := params.Info.Path.Key.(string)
+		v := obj[key]
+		if err, ok := v.(*driver.Error); ok {
+			return nil, fieldError(params.Info, err)
+		}
+		return responseErrorItems(params.Info, v), nil
+	}
+}
+
+func hasErrorItems(v interface{}) bool {
+	switch v := v.(type) {
+	case *driver.Error:
+		return true
+	case []interface{}:
+		for _, lv := range v {
+			if hasErrorItems(lv) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// responseErrorItems replaces errors in lists returned by driver with thunks raising
+// them when items are completed, so that items fail without failing the whole list
+func responseErrorItems(info graphql.ResolveInfo, v interface{}) interface{} {
+	l, ok := v.([]interface{})
+	if !ok || !hasErrorItems(l) {
+		return v
+	}
+	return errorItems(info, l, nil)
+}
+
+func errorItems(info graphql.ResolveInfo, v interface{}, path []interface{}) interface{} {
+	switch vv := v.(type) {
+	case *driver.Error:
+		err := *vv
+		err.Path = append(append([]interface{}{}, path...), vv.Path...)
+		return func() (interface{}, error) {
+			// graphql-go drops extensions and path of errors returned from thunks
+			raiseFieldError(fieldError(info, &err))
+			return nil, nil
+		}
+	case []interface{}:
+		l := make([]interface{}, len(vv))
+		for i, lv := range vv {
+			l[i] = errorItems(info, lv, append(path[:len(path):len(path)], i))
+		}
+		return l
+	}
+	return v
+}
@@ -2,14 +2,24 @@ package router
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"net/url"
 	"time"
 
 	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/mock"
+
+	// providers of functions defined by config
+	_ "github.com/graphql-editor/stucco/pkg/driver/graphqldriver"
+	_ "github.com/graphql-editor/stucco/pkg/driver/httpdriver"
 	"github.com/graphql-editor/stucco/pkg/parser"
+	"github.com/graphql-editor/stucco/pkg/pubsub"
 	"github.com/graphql-editor/stucco/pkg/types"
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
 )
 
@@ -25,6 +35,23 @@ type Router struct {
 	SubscriptionConfigs map[string]SubscriptionConfig // subscription config per subscription field
 	MaxDepth            int                           // allow limiting max depth of GraphQL recursion
 	RequestTimeout      *time.Duration
+	CircuitBreakers     *CircuitBreakers        // circuit breakers guarding function calls, nil if disabled
+	Entities            map[string]EntityConfig // Entities is a map of reference resolvers of federated entities
+	Broker              *pubsub.Broker          // Broker delivers payloads published by fields to subscriptions with topic
+
+	document     *ast.Document
+	drivers      map[driver.Config]driver.Driver
+	mock         *mock.Driver
+	federation   bool
+	sdl          string
+	mockConfig   *MockConfig
+	mockFixtures mock.Fixtures
+	mockMissing  bool
+}
+
+// dispatchDriver wraps driver with circuit breaker and call policy
+func (r *Router) dispatchDriver(dri driver.Driver, env *Environment, policy *PolicyConfig) driver.Driver {
+	return r.CircuitBreakers.wrap(withPolicy(dri, policy), env)
 }
 
 func (r *Router) bindInterfaces(c *parser.Config) error {
@@ -40,7 +67,7 @@ func (r *Router) bindInterfaces(c *parser.Config) error {
 			return err
 		}
 		c.Interfaces[k] = Dispatch{
-			Driver:  dri,
+			Driver:  r.dispatchDriver(dri, i.Environment, i.Policy),
 			TypeMap: &r.Schema,
 		}.InterfaceResolveType(i)
 	}
@@ -59,27 +86,70 @@ func passthroughFieldResolver(params graphql.ResolveParams) (interface{}, error)
 
 func (r *Router) bindResolvers(c *parser.Config) error {
 	for k, rs := range r.Resolvers {
-		dri, err := r.getDriver(driver.Config{
-			Provider: rs.Environment.Provider,
-			Runtime:  rs.Environment.Runtime,
-		})
-		if err != nil {
-			return err
+		// authorize only configs do not replace field resolver
+		if isTypeWildcard(k) || (rs.Authorize != nil && !rs.resolves()) {
+			continue
 		}
-		switch rs.Skip {
-		case true:
-			c.Resolvers[k] = passthroughFieldResolver
+		var fn graphql.FieldResolveFn
+		switch {
+		case rs.Skip:
+			fn = passthroughFieldResolver
+		case rs.declarative():
+			var err error
+			if fn, err = declarativeFieldResolver(rs); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+		case rs.Publish != nil && rs.Resolve.Name == "" && rs.Function == nil:
+			fn = argumentsFieldResolver
 		default:
-			c.Resolvers[k] = Dispatch{
-				Driver:   dri,
+			dri, err := r.resolverDriver(k, &rs)
+			if err != nil {
+				return err
+			}
+			d := Dispatch{
+				Driver:   r.dispatchDriver(dri, rs.Environment, rs.Policy),
 				TypeMap:  &r.Schema,
 				MaxDepth: r.MaxDepth,
-			}.FieldResolve(rs)
+			}
+			fn = d.FieldResolve(rs)
+			if rs.Function == nil {
+				fn = d.StreamFieldResolve(rs, fn)
+			}
+		}
+		if rs.Publish != nil {
+			var err error
+			if fn, err = r.publishFieldResolver(fn, *rs.Publish); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
 		}
+		c.Resolvers[k] = fn
 	}
 	return nil
 }
 
+// resolverDriver returns driver of resolver, function described by resolver config is
+// added to driver under field name unless function name is set
+func (r *Router) resolverDriver(field string, rs *ResolverConfig) (driver.Driver, error) {
+	dri, err := r.getDriver(driver.Config{
+		Provider: rs.Environment.Provider,
+		Runtime:  rs.Environment.Runtime,
+	})
+	if err != nil || rs.Function == nil {
+		return dri, err
+	}
+	configurer, ok := dri.(driver.FunctionConfigurer)
+	if !ok {
+		return nil, fmt.Errorf("%s: %s provider does not support function config", field, rs.Environment.Provider)
+	}
+	if rs.Resolve.Name == "" {
+		rs.Resolve.Name = field
+	}
+	if err := configurer.AddFunction(rs.Resolve.Name, rs.Function); err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	return dri, nil
+}
+
 func (r *Router) bindScalars(c *parser.Config) error {
 	for k, s := range r.Scalars {
 		dri, err := r.getDriver(driver.Config{
@@ -90,7 +160,7 @@ func (r *Router) bindScalars(c *parser.Config) error {
 			return err
 		}
 		c.Scalars[k] = Dispatch{
-			Driver:  dri,
+			Driver:  r.dispatchDriver(dri, s.Environment, s.Policy),
 			TypeMap: &r.Schema,
 		}.ScalarFunctions(s)
 	}
@@ -107,7 +177,7 @@ func (r *Router) bindUnions(c *parser.Config) error {
 			return err
 		}
 		c.Unions[k] = Dispatch{
-			Driver:  dri,
+			Driver:  r.dispatchDriver(dri, u.Environment, u.Policy),
 			TypeMap: &r.Schema,
 		}.UnionResolveType(u)
 	}
@@ -120,6 +190,7 @@ func (r *Router) parserConfig() (parser.Config, error) {
 		Resolvers:  make(map[string]graphql.FieldResolveFn, len(r.Resolvers)),
 		Scalars:    make(map[string]parser.ScalarFunctions, len(r.Scalars)),
 		Unions:     make(map[string]graphql.ResolveTypeFn, len(r.Unions)),
+		Federation: r.federation,
 	}
 	for _, f := range []func(c *parser.Config) error{
 		r.bindInterfaces,
@@ -135,10 +206,14 @@ func (r *Router) parserConfig() (parser.Config, error) {
 }
 
 func (r *Router) parseSchema(c Config) error {
-	source, err := c.rawSchema()
+	source, err := c.RawSchema()
 	if err != nil {
 		return err
 	}
+	if source, err = r.mergeRemoteTypes(source); err != nil {
+		return err
+	}
+	r.sdl = source
 	pConfig, err := r.parserConfig()
 	if err != nil {
 		return err
@@ -149,6 +224,16 @@ func (r *Router) parseSchema(c Config) error {
 		return err
 	}
 	r.Schema = schema
+	r.document = p.Document()
+	r.bindResponseObjects()
+	r.bindMockResolvers()
+	if err := r.bindFederation(); err != nil {
+		return err
+	}
+	if err := r.bindFieldAuthorizers(); err != nil {
+		return err
+	}
+	r.bindScalarContext()
 	return nil
 }
 
@@ -164,12 +249,24 @@ func (r *Router) setDriverSecrets(dri driver.Driver) error {
 }
 
 func (r *Router) getDriver(cfg driver.Config) (dri driver.Driver, err error) {
+	if dri, ok := r.drivers[cfg]; ok {
+		return dri, nil
+	}
 	dri = driver.GetDriver(cfg)
 	if dri == nil {
 		err = errors.New("driver not found")
 		return
 	}
-	err = r.setDriverSecrets(dri)
+	if md, ok := dri.(*mock.Driver); ok {
+		r.configureMock(md)
+	}
+	if err = r.setDriverSecrets(dri); err != nil {
+		return
+	}
+	if r.drivers == nil {
+		r.drivers = make(map[driver.Config]driver.Driver)
+	}
+	r.drivers[cfg] = dri
 	return
 }
 
@@ -191,11 +288,26 @@ func (r *Router) load(c Config) error {
 		u.Environment = newEnvironment(u.Environment, c.Environment)
 		r.Unions[k] = u
 	}
+	if c.Federation != nil {
+		r.federation = true
+		for k, e := range c.Federation.Entities {
+			e.Environment = newEnvironment(e.Environment, c.Environment)
+			r.Entities[k] = e
+		}
+	}
+	if err := r.loadMock(c); err != nil {
+		return err
+	}
 	if err := r.parseSchema(c); err != nil {
 		return err
 	}
 	extensions := []graphql.Extension{
 		routerStartContext{},
+		deferExtension{},
+	}
+	if c.Cost != nil {
+		schema := r.Schema
+		extensions = append(extensions, newCostExtension(*c.Cost, &schema, r.document, r.Resolvers))
 	}
 	if c.Authorize != nil && c.Authorize.Authorize.Name != "" {
 		env := newEnvironment(c.Authorize.Environment, c.Environment)
@@ -207,10 +319,10 @@ func (r *Router) load(c Config) error {
 			return err
 		}
 		dispatch := Dispatch{
-			Driver: dri,
+			Driver: r.dispatchDriver(dri, env, c.Authorize.Policy),
 		}
 		extensions = append(extensions, authorizeExtension{
-			authorizeHandler: dispatch.Authorize(*c.Authorize),
+			authorizeHandler: dispatch.AuthorizeClaims(*c.Authorize),
 		})
 	}
 	if r.Schema.SubscriptionType() != nil {
@@ -228,8 +340,10 @@ func (r *Router) load(c Config) error {
 			return err
 		}
 		r.SubscriptionConfigs = c.SubscriptionConfigs
+		// extensions are identified by name, so fields of a kind are handled by
+		// one extension with drivers set per field
+		kinds := map[SubscriptionKind]subscribeExtension{ext.kind(): ext}
 		for k, v := range r.SubscriptionConfigs {
-			ext.Exclude(k)
 			fenv := newEnvironment(v.Environment, *env)
 			ndri := dri
 			if fenv.Provider != env.Provider || fenv.Runtime != env.Runtime {
@@ -239,18 +353,32 @@ func (r *Router) load(c Config) error {
 				})
 			}
 			if err == nil {
-				var fext subscribeExtension
 				if v.Kind == DefaultSubscription {
 					v.Kind = c.Subscriptions.Kind
 				}
-				if fext, err = newSubscriptionExtension(v, r, ndri); err == nil {
-					fext.Include(k)
-					r.Schema.AddExtensions(fext)
+				if v.Topic != "" {
+					err = r.bindSubscriptionTopic(k, v)
+				}
+			}
+			var fext subscribeExtension
+			if err == nil {
+				if fext, err = newSubscriptionExtension(v, r, dri); err == nil {
+					if kext, ok := kinds[fext.kind()]; ok {
+						fext = kext
+					} else {
+						kinds[fext.kind()] = fext
+						r.Schema.AddExtensions(fext)
+					}
 				}
 			}
 			if err != nil {
 				return err
 			}
+			if fext != ext {
+				ext.Exclude(k)
+				fext.Include(k)
+			}
+			fext.SetDriver(k, ndri)
 		}
 		extensions = append(extensions, ext)
 	}
@@ -268,10 +396,18 @@ func NewRouter(c Config) (Router, error) {
 		Resolvers:      make(map[string]ResolverConfig, len(c.Resolvers)),
 		Scalars:        make(map[string]ScalarConfig, len(c.Scalars)),
 		Unions:         make(map[string]UnionConfig, len(c.Unions)),
+		Entities:       make(map[string]EntityConfig),
+		Broker:         c.Broker,
 		Subscriptions:  c.Subscriptions,
 		MaxDepth:       c.MaxDepth,
 		RequestTimeout: &t,
 	}
+	if r.Broker == nil {
+		r.Broker = pubsub.New()
+	}
+	if c.CircuitBreaker != nil {
+		r.CircuitBreakers = NewCircuitBreakers(*c.CircuitBreaker)
+	}
 	err := r.load(c)
 	return r, err
 }
@@ -287,6 +423,8 @@ type SubscribeContext struct {
 	Reader              driver.SubscriptionListenReader `json:"-"`
 	info                *graphql.ResolveInfo            `json:"-"`
 	resolvedTo          interface{}                     `json:"-"`
+	arguments           map[string]interface{}          `json:"-"`
+	result              interface{}                     `json:"-"`
 }
 
 type subscriptionExtensionKeyType string
@@ -301,6 +439,7 @@ type SubscribeExtension struct {
 	baseExtension
 	router  *Router
 	dri     driver.Driver
+	drivers map[string]driver.Driver
 	include []string
 	exclude []string
 }
@@ -315,6 +454,21 @@ func (s *SubscribeExtension) Exclude(f string) {
 	s.exclude = append(s.exclude, f)
 }
 
+// SetDriver sets driver used by field
+func (s *SubscribeExtension) SetDriver(f string, dri driver.Driver) {
+	if s.drivers == nil {
+		s.drivers = make(map[string]driver.Driver)
+	}
+	s.drivers[f] = dri
+}
+
+func (s *SubscribeExtension) driver(ctx *SubscribeContext) driver.Driver {
+	if dri, ok := s.drivers[s.fieldName(ctx)]; ok {
+		return dri
+	}
+	return s.dri
+}
+
 // Init implements graphql.Extension
 func (s *SubscribeExtension) Init(ctx context.Context, p *graphql.Params) context.Context {
 	if ctx.Value(subscriptionExtensionKey) == nil {
@@ -359,6 +513,41 @@ func (s *SubscribeExtension) ExecutionDidStart(ctx context.Context) (context.Con
 	}
 }
 
+// executionDidStart starts subscription with subscribe after execution of subscription
+// finishes. Error returned by subscribe is returned as an error of subscription field.
+func (s *SubscribeExtension) executionDidStart(
+	ctx context.Context,
+	subscribe func(*SubscribeContext) (interface{}, error),
+) (context.Context, graphql.ExecutionFinishFunc) {
+	return ctx, func(r *graphql.Result) {
+		if getRouterError(ctx) != nil {
+			return
+		}
+		subCtx := ctx.Value(subscriptionExtensionKey).(*SubscribeContext)
+		if !subCtx.IsSubscription || !s.handle(subCtx) {
+			return
+		}
+		r.Data = nil
+		r.Errors = nil
+		result, err := subscribe(subCtx)
+		if err != nil {
+			r.Errors = []gqlerrors.FormattedError{subscriptionError(subCtx, err)}
+			return
+		}
+		subCtx.result = result
+	}
+}
+
+func subscriptionError(ctx *SubscribeContext, err error) gqlerrors.FormattedError {
+	nodes := make([]ast.Node, 0, len(ctx.info.FieldASTs))
+	for _, f := range ctx.info.FieldASTs {
+		nodes = append(nodes, f)
+	}
+	return gqlerrors.FormatError(
+		gqlerrors.NewErrorWithPath(err.Error(), nodes, "", nil, []int{}, ctx.info.Path.AsArray(), err),
+	)
+}
+
 // ResolveFieldDidStart implements graphql.Extension
 // Hijacks the resolution of root subscription fields
 func (s *SubscribeExtension) ResolveFieldDidStart(ctx context.Context, info *graphql.ResolveInfo) (context.Context, graphql.ResolveFieldFinishFunc) {
@@ -441,7 +630,7 @@ func (b *BlockingSubscriptionExtension) kind() SubscriptionKind {
 }
 
 func (b *BlockingSubscriptionExtension) internalSubscription(ctx *SubscribeContext) (driver.SubscriptionListenOutput, error) {
-	dri := b.dri
+	dri := b.driver(ctx)
 	cfg, err := b.subscriptionConfig(ctx)
 	var out driver.SubscriptionListenOutput
 	if err == nil {
@@ -452,37 +641,51 @@ func (b *BlockingSubscriptionExtension) internalSubscription(ctx *SubscribeConte
 			OperationName:  ctx.OperationName,
 			Operation:      ctx.OperationDefinition,
 			Protocol:       ctx.Context.Value(ProtocolKey),
+			Claims:         claimsFromContext(ctx.Context),
 		}
 		var nout *driver.SubscriptionListenOutput
 		if h, ok := ctx.resolvedTo.(BlockingSubscriptionHandler); ok {
 			nout, err = h.SubscriptionListen(in)
 		}
 		if err == nil {
-			if nout != nil {
+			switch {
+			case nout != nil:
 				out = *nout
-			} else {
-				out = dri.SubscriptionListen(in)
+			case cfg.Topic != "":
+				var topic string
+				if topic, err = subscriptionTopic(cfg.Topic, ctx.arguments); err == nil {
+					out.Reader = b.router.Broker.Subscribe(topic)
+				}
+			default:
+				// listener outlives the request that created it, it is
+				// stopped by closing the reader
+				out = driver.WithContext(dri).SubscriptionListenContext(context.Background(), in)
 			}
 		}
 	}
 	return out, err
 }
 
+// ExecutionDidStart implements graphql.Extension
+func (b *BlockingSubscriptionExtension) ExecutionDidStart(ctx context.Context) (context.Context, graphql.ExecutionFinishFunc) {
+	return b.executionDidStart(ctx, func(subCtx *SubscribeContext) (interface{}, error) {
+		tout, err := b.internalSubscription(subCtx)
+		if err == nil && tout.Error != nil {
+			err = driverError{err: tout.Error}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return BlockingSubscriptionPayload{
+			Reader:  tout.Reader,
+			Context: *subCtx,
+		}, nil
+	})
+}
+
 // GetResult implements graphql.Extension
 func (b *BlockingSubscriptionExtension) GetResult(ctx context.Context) interface{} {
-	subCtx := ctx.Value(subscriptionExtensionKey).(*SubscribeContext)
-	tout, err := b.internalSubscription(subCtx)
-	if err != nil || tout.Error != nil {
-		if err == nil {
-			err = errors.New(tout.Error.Message)
-		}
-		routerError(ctx, err)
-		return nil
-	}
-	return BlockingSubscriptionPayload{
-		Reader:  tout.Reader,
-		Context: *subCtx,
-	}
+	return ctx.Value(subscriptionExtensionKey).(*SubscribeContext).result
 }
 
 // ExternalSubscriptionHandler can be implemented by root object on API to prepare connection data
@@ -506,7 +709,7 @@ func (e *ExternalSubscriptionExtension) kind() SubscriptionKind {
 }
 
 func (e *ExternalSubscriptionExtension) externalSubscription(ctx *SubscribeContext) (driver.SubscriptionConnectionOutput, error) {
-	dri := e.dri
+	dri := e.driver(ctx)
 	cfg, err := e.subscriptionConfig(ctx)
 	if err == nil && cfg.CreateConnection.Name == "" {
 		err = errors.New("connection create function required for external subscription")
@@ -519,6 +722,7 @@ func (e *ExternalSubscriptionExtension) externalSubscription(ctx *SubscribeConte
 			VariableValues: ctx.VariableValues,
 			OperationName:  ctx.OperationName,
 			Protocol:       ctx.Context.Value(ProtocolKey),
+			Claims:         claimsFromContext(ctx.Context),
 		}
 		var nout *driver.SubscriptionConnectionOutput
 		if h, ok := ctx.resolvedTo.(ExternalSubscriptionHandler); ok {
@@ -528,31 +732,139 @@ func (e *ExternalSubscriptionExtension) externalSubscription(ctx *SubscribeConte
 			if nout != nil {
 				out = *nout
 			} else {
-				out = dri.SubscriptionConnection(in)
+				out = driver.WithContext(dri).SubscriptionConnectionContext(requestContext(ctx.Context), in)
 			}
 		}
 	}
 	return out, err
 }
 
+// ExecutionDidStart implements graphql.Extension
+func (e *ExternalSubscriptionExtension) ExecutionDidStart(ctx context.Context) (context.Context, graphql.ExecutionFinishFunc) {
+	return e.executionDidStart(ctx, func(subCtx *SubscribeContext) (interface{}, error) {
+		tout, err := e.externalSubscription(subCtx)
+		if err == nil && tout.Error != nil {
+			err = driverError{err: tout.Error}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return tout, nil
+	})
+}
+
 // GetResult implements graphql.Extension
 func (e *ExternalSubscriptionExtension) GetResult(ctx context.Context) interface{} {
-	subCtx := ctx.Value(subscriptionExtensionKey).(*SubscribeContext)
-	tout, err := e.externalSubscription(subCtx)
-	if err != nil || tout.Error != nil {
-		if err == nil {
-			err = errors.New(tout.Error.Message)
+	return ctx.Value(subscriptionExtensionKey).(*SubscribeContext).result
+}
+
+// RedirectSubscriptionPayload is a result of redirect subscription
+type RedirectSubscriptionPayload struct {
+	// URL of subscription service with query, variables and operation name of subscription
+	URL string
+}
+
+// RedirectSubscriptionHandler can be implemented by root object on API to return redirect address
+// If SubscriptionRedirect returns an error, that error will be returned. If it returns nil error and empty address, then further execution is attempted. Otherwise address returned by handler is used to prepare extension output.
+type RedirectSubscriptionHandler interface {
+	SubscriptionRedirect(driver.SubscriptionConnectionInput) (string, error)
+}
+
+// RedirectSubscriptionExtension is a redirect subscription extension
+type RedirectSubscriptionExtension struct {
+	SubscribeExtension
+}
+
+// Name implements graphql.Extension
+func (e *RedirectSubscriptionExtension) Name() string {
+	return "subscriptionRedirect"
+}
+
+func (e *RedirectSubscriptionExtension) kind() SubscriptionKind {
+	return RedirectSubscription
+}
+
+func (e *RedirectSubscriptionExtension) redirectSubscription(ctx *SubscribeContext) (string, error) {
+	cfg, err := e.subscriptionConfig(ctx)
+	if err == nil && cfg.Redirect.Name == "" {
+		err = errors.New("redirect function required for redirect subscription")
+	}
+	if err != nil {
+		return "", err
+	}
+	in := driver.SubscriptionConnectionInput{
+		Function:       cfg.Redirect,
+		Query:          ctx.Query,
+		VariableValues: ctx.VariableValues,
+		OperationName:  ctx.OperationName,
+		Operation:      ctx.OperationDefinition,
+		Protocol:       ctx.Context.Value(ProtocolKey),
+		Claims:         claimsFromContext(ctx.Context),
+	}
+	if h, ok := ctx.resolvedTo.(RedirectSubscriptionHandler); ok {
+		addr, err := h.SubscriptionRedirect(in)
+		if err != nil || addr != "" {
+			return addr, err
+		}
+	}
+	out := driver.WithContext(e.driver(ctx)).SubscriptionConnectionContext(requestContext(ctx.Context), in)
+	if out.Error != nil {
+		return "", driverError{err: out.Error}
+	}
+	addr, ok := out.Response.(string)
+	if !ok || addr == "" {
+		return "", errors.New("redirect function must return an address")
+	}
+	return addr, nil
+}
+
+// redirectURL adds query, variables and operation name of subscription to address
+func redirectURL(addr string, ctx *SubscribeContext) (string, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("query", ctx.Query)
+	if len(ctx.VariableValues) > 0 {
+		b, err := json.Marshal(ctx.VariableValues)
+		if err != nil {
+			return "", err
 		}
-		routerError(ctx, err)
-		return nil
+		q.Set("variables", string(b))
 	}
-	return tout
+	if ctx.OperationName != "" {
+		q.Set("operationName", ctx.OperationName)
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// ExecutionDidStart implements graphql.Extension
+func (e *RedirectSubscriptionExtension) ExecutionDidStart(ctx context.Context) (context.Context, graphql.ExecutionFinishFunc) {
+	return e.executionDidStart(ctx, func(subCtx *SubscribeContext) (interface{}, error) {
+		addr, err := e.redirectSubscription(subCtx)
+		if err == nil {
+			addr, err = redirectURL(addr, subCtx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return RedirectSubscriptionPayload{URL: addr}, nil
+	})
+}
+
+// GetResult implements graphql.Extension
+func (e *RedirectSubscriptionExtension) GetResult(ctx context.Context) interface{} {
+	return ctx.Value(subscriptionExtensionKey).(*SubscribeContext).result
 }
 
 type subscribeExtension interface {
 	graphql.Extension
 	Exclude(string)
 	Include(string)
+	SetDriver(string, driver.Driver)
+	kind() SubscriptionKind
 }
 
 func newSubscriptionExtension(cfg SubscriptionConfig, r *Router, dri driver.Driver) (subscribeExtension, error) {
@@ -569,6 +881,10 @@ func newSubscriptionExtension(cfg SubscriptionConfig, r *Router, dri driver.Driv
 		return &ExternalSubscriptionExtension{
 			SubscribeExtension: ext,
 		}, nil
+	case RedirectSubscription:
+		return &RedirectSubscriptionExtension{
+			SubscribeExtension: ext,
+		}, nil
 	default:
 		return nil, errors.New("this subscription kind is not implemented yet")
 	}
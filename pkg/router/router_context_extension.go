@@ -15,6 +15,10 @@ const ContextKey routerContext = 0
 // Context context associated with request
 type Context struct {
 	Error error
+	// Claims returned by authorize function for request
+	Claims  interface{}
+	batches fieldBatcher
+	params  *graphql.Params
 }
 
 type baseExtension struct{}
@@ -51,7 +55,7 @@ type routerStartContext struct {
 }
 
 func (r routerStartContext) Init(ctx context.Context, p *graphql.Params) context.Context {
-	ctx = context.WithValue(ctx, ContextKey, &Context{})
+	ctx = context.WithValue(ctx, ContextKey, &Context{params: p})
 	return ctx
 }
 func (r routerStartContext) Name() string { return "RouterStartExtension" }
@@ -62,22 +66,64 @@ type routerFinishContext struct {
 
 func (r routerFinishContext) Name() string { return "RouterFinishExtension" }
 
+// ValidationDidStart aborts request with router error, like failed authorization,
+// before any field is resolved
+func (r routerFinishContext) ValidationDidStart(ctx context.Context) (context.Context, graphql.ValidationFinishFunc) {
+	return ctx, func([]gqlerrors.FormattedError) {
+		if err := getRouterError(ctx); err != nil {
+			abortExecution(ctx, err)
+		}
+	}
+}
+
+// ExecutionDidStart replaces result with router error, if there was one. Router errors,
+// like failed authorization, abort whole request.
 func (r routerFinishContext) ExecutionDidStart(ctx context.Context) (context.Context, graphql.ExecutionFinishFunc) {
-	assertRouterOk(ctx)
 	return ctx, func(r *graphql.Result) {
 		if err := getRouterError(ctx); err != nil {
 			r.Data = nil
-			r.Errors = nil
-			panic(err)
+			r.Errors = []gqlerrors.FormattedError{formatError(err)}
 		}
 	}
 }
 
+// abortedOperationName is not a valid GraphQL name, so no operation of request has it
+const abortedOperationName = "aborted operation"
+
+// abortExecution sets router error of request and makes executor reject request before
+// any field is resolved. Operation executed by graphql.Do is selected with params after
+// validation finishes, so request must be aborted during validation. Result is replaced
+// with router error when execution finishes.
+func abortExecution(ctx context.Context, err error) {
+	rtContext := getRouterContext(ctx)
+	if rtContext == nil {
+		return
+	}
+	if rtContext.Error == nil {
+		rtContext.Error = err
+	}
+	if rtContext.params != nil {
+		rtContext.params.OperationName = abortedOperationName
+	}
+}
+
 func getRouterContext(ctx context.Context) *Context {
 	if ctx == nil {
 		return nil
 	}
-	return ctx.Value(ContextKey).(*Context)
+	rtContext, _ := ctx.Value(ContextKey).(*Context)
+	return rtContext
+}
+
+// claimsFromContext returns claims of authorized request, if there are any
+func claimsFromContext(ctx context.Context) interface{} {
+	if ctx == nil {
+		return nil
+	}
+	if rtContext, _ := ctx.Value(ContextKey).(*Context); rtContext != nil {
+		return rtContext.Claims
+	}
+	return nil
 }
 
 func getRouterError(ctx context.Context) error {
@@ -87,15 +133,3 @@ func getRouterError(ctx context.Context) error {
 	}
 	return err
 }
-
-func assertRouterOk(ctx context.Context) {
-	if err := getRouterError(ctx); err != nil {
-		panic(err)
-	}
-}
-
-func routerError(ctx context.Context, err error) {
-	if rtContext := getRouterContext(ctx); rtContext.Error == nil {
-		rtContext.Error = err
-	}
-}
@@ -0,0 +1,215 @@
+package router
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+)
+
+// graphql-go calls parse and serialize functions of scalars without context of request.
+// To pass claims of request to scalar functions, values of scalars implemented by
+// functions carry context of request. Parse function returns a scalarInput which is
+// parsed once a field using it is resolved, while resolvers of fields returning scalars
+// wrap their results in scalarOutput before they are serialized.
+
+type scalarContextKey int
+
+// scalarOutputKey marks context of field which result is serialized by scalar function
+const scalarOutputKey scalarContextKey = 0
+
+// scalarInput is a value of argument or variable waiting to be parsed by scalar function
+type scalarInput struct {
+	value  interface{}
+	parse  func(ctx context.Context, v interface{}) (interface{}, error)
+	once   sync.Once
+	result interface{}
+	err    error
+}
+
+// get parses value once, value used by many fields of a request is parsed only
+// by first of them
+func (s *scalarInput) get(ctx context.Context) (interface{}, error) {
+	s.once.Do(func() {
+		s.result, s.err = s.parse(ctx, s.value)
+	})
+	return s.result, s.err
+}
+
+// scalarOutput is a result of field with context of request in which it was resolved
+type scalarOutput struct {
+	ctx   context.Context
+	value interface{}
+}
+
+func hasScalarInput(v interface{}) bool {
+	switch v := v.(type) {
+	case *scalarInput:
+		return true
+	case map[string]interface{}:
+		for _, mv := range v {
+			if hasScalarInput(mv) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, lv := range v {
+			if hasScalarInput(lv) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseScalars returns a copy of v with scalar inputs replaced by values
+// returned from parse functions
+func parseScalars(ctx context.Context, v interface{}) (interface{}, error) {
+	if !hasScalarInput(v) {
+		return v, nil
+	}
+	var err error
+	switch v := v.(type) {
+	case *scalarInput:
+		return v.get(ctx)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, mv := range v {
+			if m[k], err = parseScalars(ctx, mv); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, lv := range v {
+			if l[i], err = parseScalars(ctx, lv); err != nil {
+				return nil, err
+			}
+		}
+		return l, nil
+	}
+	return v, nil
+}
+
+// parseScalarVariables returns variable values passed to functions. Variables
+// that could not be parsed are null, the error is reported by fields using them.
+func parseScalarVariables(ctx context.Context, vars map[string]interface{}) map[string]interface{} {
+	if !hasScalarInput(vars) {
+		return vars
+	}
+	parsed := make(map[string]interface{}, len(vars))
+	for k, v := range vars {
+		parsed[k], _ = parseScalars(ctx, v)
+	}
+	return parsed
+}
+
+func isNilValue(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// wrapScalarOutput wraps scalar values of result of type t with context of request
+func wrapScalarOutput(ctx context.Context, t graphql.Type, v interface{}) interface{} {
+	if isNilValue(v) {
+		return v
+	}
+	switch tt := t.(type) {
+	case *graphql.NonNull:
+		return wrapScalarOutput(ctx, tt.OfType, v)
+	case *graphql.List:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			// invalid list value is reported by graphql-go
+			return v
+		}
+		items := make([]interface{}, rv.Len())
+		for i := range items {
+			items[i] = wrapScalarOutput(ctx, tt.OfType, rv.Index(i).Interface())
+		}
+		return items
+	}
+	switch v.(type) {
+	case *scalarOutput, func() (interface{}, error):
+		// items that failed are raised when they are completed
+		return v
+	}
+	return &scalarOutput{ctx: ctx, value: v}
+}
+
+// scalarContextResolve creates a resolver that parses scalar inputs in arguments and,
+// if serialize is true, passes context to serialize function of scalar returned by field
+func scalarContextResolve(returnType graphql.Type, serialize bool, resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	if resolve == nil {
+		resolve = graphql.DefaultResolveFn
+	}
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		ctx := requestContext(params.Context)
+		args, err := parseScalars(ctx, params.Args)
+		if err != nil {
+			return nil, err
+		}
+		params.Args, _ = args.(map[string]interface{})
+		if !serialize {
+			return resolve(params)
+		}
+		params.Context = context.WithValue(ctx, scalarOutputKey, true)
+		v, err := resolve(params)
+		if err != nil {
+			return v, err
+		}
+		if thunk, ok := v.(func() (interface{}, error)); ok {
+			return func() (interface{}, error) {
+				v, err := thunk()
+				if err != nil {
+					return v, err
+				}
+				return wrapScalarOutput(ctx, returnType, v), nil
+			}, nil
+		}
+		return wrapScalarOutput(ctx, returnType, v), nil
+	}
+}
+
+// serializesScalar returns true if values of field are serialized by scalar function
+func serializesScalar(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	ok, _ := ctx.Value(scalarOutputKey).(bool)
+	return ok
+}
+
+// bindScalarContext wraps resolvers of fields with arguments or returning scalars
+// implemented by functions, so that scalar functions are called with context of request
+func (r *Router) bindScalarContext() {
+	if len(r.Scalars) == 0 {
+		return
+	}
+	for name, t := range r.Schema.TypeMap() {
+		obj, ok := t.(*graphql.Object)
+		if !ok || strings.HasPrefix(name, "__") {
+			continue
+		}
+		for _, def := range obj.Fields() {
+			var serialize bool
+			if scalar, ok := graphql.GetNamed(def.Type).(*graphql.Scalar); ok {
+				_, serialize = r.Scalars[scalar.Name()]
+			}
+			if !serialize && len(def.Args) == 0 {
+				continue
+			}
+			def.Resolve = scalarContextResolve(def.Type, serialize, def.Resolve)
+		}
+	}
+}
@@ -0,0 +1,383 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+type streamsKey int
+
+// streamsContextKey is a context key of streams of operation
+const streamsContextKey streamsKey = 0
+
+// Streams collects lists of fields marked with @stream directive which
+// are read from functions after initial result of operation is delivered
+type Streams struct {
+	ctx     context.Context
+	lock    sync.Mutex
+	streams []*Stream
+	schemas objectSchemas
+}
+
+// NewStreams returns new streams of operation, streams are read from functions
+// until context is done
+func NewStreams(ctx context.Context) *Streams {
+	return &Streams{ctx: ctx}
+}
+
+// WithStreams returns context in which resolved lists of fields marked with
+// @stream directive are added to streams
+func WithStreams(ctx context.Context, s *Streams) context.Context {
+	return context.WithValue(ctx, streamsContextKey, s)
+}
+
+func streamsFromContext(ctx context.Context) *Streams {
+	if ctx == nil {
+		return nil
+	}
+	s, _ := ctx.Value(streamsContextKey).(*Streams)
+	return s
+}
+
+// Take removes and returns streams added to operation
+func (s *Streams) Take() []*Stream {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	streams := s.streams
+	s.streams = nil
+	return streams
+}
+
+func (s *Streams) add(st *Stream) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.streams = append(s.streams, st)
+}
+
+// objectSchemas are schemas with objects as query types used to execute
+// selections on resolved values
+type objectSchemas struct {
+	lock    sync.Mutex
+	schemas map[string]*graphql.Schema
+}
+
+// schema returns schema with object as query type
+func (o *objectSchemas) schema(base graphql.Schema, object *graphql.Object) (*graphql.Schema, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if schema, ok := o.schemas[object.Name()]; ok {
+		return schema, nil
+	}
+	typeMap := base.TypeMap()
+	types := make([]graphql.Type, 0, len(typeMap))
+	for _, t := range typeMap {
+		types = append(types, t)
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query:      object,
+		Types:      types,
+		Directives: base.Directives(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	schema.AddExtensions(deferExtension{})
+	if o.schemas == nil {
+		o.schemas = make(map[string]*graphql.Schema)
+	}
+	o.schemas[object.Name()] = &schema
+	return &schema, nil
+}
+
+// selectionDocument returns query with selection set executed on resolved value of field
+func selectionDocument(info graphql.ResolveInfo, selectionSet *ast.SelectionSet) *ast.Document {
+	op := ast.NewOperationDefinition(&ast.OperationDefinition{
+		Operation:    ast.OperationTypeQuery,
+		SelectionSet: selectionSet,
+	})
+	if infoOp, ok := info.Operation.(*ast.OperationDefinition); ok {
+		op.VariableDefinitions = infoOp.VariableDefinitions
+	}
+	doc := ast.NewDocument(&ast.Document{Definitions: []ast.Node{op}})
+	for _, f := range info.Fragments {
+		doc.Definitions = append(doc.Definitions, f)
+	}
+	return doc
+}
+
+// resolveObjectType returns object type of value of abstract type
+func resolveObjectType(params graphql.ResolveParams, t graphql.Abstract, v interface{}) *graphql.Object {
+	p := graphql.ResolveTypeParams{
+		Value:   v,
+		Info:    params.Info,
+		Context: params.Context,
+	}
+	var resolveType graphql.ResolveTypeFn
+	switch tt := t.(type) {
+	case *graphql.Interface:
+		resolveType = tt.ResolveType
+	case *graphql.Union:
+		resolveType = tt.ResolveType
+	}
+	if resolveType != nil {
+		return resolveType(p)
+	}
+	for _, object := range params.Info.Schema.PossibleTypes(t) {
+		if object.IsTypeOf != nil && object.IsTypeOf(graphql.IsTypeOfParams{
+			Value:   v,
+			Info:    params.Info,
+			Context: params.Context,
+		}) {
+			return object
+		}
+	}
+	return nil
+}
+
+// Stream is a remainder of list read from function
+type Stream struct {
+	Label  string
+	Path   []interface{}
+	index  int
+	done   bool
+	once   sync.Once
+	reader driver.StreamReader
+	item   streamItem
+}
+
+// Next blocks until next item of list is read and returns it as incremental result,
+// false is returned when there are no more items
+func (s *Stream) Next() (IncrementalResult, bool) {
+	if s.done {
+		return IncrementalResult{}, false
+	}
+	path := appendPath(s.Path, s.index)
+	if !s.reader.Next() {
+		s.done = true
+		if err := s.reader.Error(); err != nil {
+			return IncrementalResult{
+				Path:   path,
+				Label:  s.Label,
+				Errors: []gqlerrors.FormattedError{{Message: err.Error(), Path: path}},
+			}, true
+		}
+		return IncrementalResult{}, false
+	}
+	msg := s.reader.Read()
+	if msg.Error != nil {
+		s.done = true
+		return IncrementalResult{
+			Path:   path,
+			Label:  s.Label,
+			Errors: []gqlerrors.FormattedError{{Message: msg.Error.Message, Path: path}},
+		}, true
+	}
+	s.index++
+	v, errs := s.item.complete(msg.Response, path)
+	return IncrementalResult{
+		Items:  []interface{}{v},
+		Path:   path,
+		Label:  s.Label,
+		Errors: errs,
+	}, true
+}
+
+// Close stops reading from function, it is safe to call Close while
+// Next is blocked
+func (s *Stream) Close() {
+	s.once.Do(s.reader.Close)
+}
+
+// streamItem completes items of streamed list with selections of field
+type streamItem struct {
+	streams *Streams
+	params  graphql.ResolveParams
+	claims  interface{}
+}
+
+func (s streamItem) complete(v interface{}, path []interface{}) (interface{}, []gqlerrors.FormattedError) {
+	itemType := s.params.Info.ReturnType
+	if nonNull, ok := itemType.(*graphql.NonNull); ok {
+		itemType = nonNull.OfType
+	}
+	if list, ok := itemType.(*graphql.List); ok {
+		itemType = list.OfType
+	}
+	return s.completeValue(itemType, v, path)
+}
+
+func (s streamItem) completeValue(t graphql.Type, v interface{}, path []interface{}) (interface{}, []gqlerrors.FormattedError) {
+	if nonNull, ok := t.(*graphql.NonNull); ok {
+		completed, errs := s.completeValue(nonNull.OfType, v, path)
+		if completed == nil && len(errs) == 0 {
+			errs = []gqlerrors.FormattedError{{
+				Message: fmt.Sprintf("Cannot return null for non-nullable field %v.%v.", s.params.Info.ParentType, s.params.Info.FieldName),
+				Path:    path,
+			}}
+		}
+		return completed, errs
+	}
+	if v == nil {
+		return nil, nil
+	}
+	switch tt := t.(type) {
+	case *graphql.List:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return nil, []gqlerrors.FormattedError{{Message: "expected list value", Path: path}}
+		}
+		var errs []gqlerrors.FormattedError
+		completed := make([]interface{}, rv.Len())
+		for i := range completed {
+			var itemErrs []gqlerrors.FormattedError
+			completed[i], itemErrs = s.completeValue(tt.OfType, rv.Index(i).Interface(), appendPath(path, i))
+			errs = append(errs, itemErrs...)
+		}
+		return completed, errs
+	case *graphql.Scalar:
+		if serializesScalar(s.params.Context) {
+			v = &scalarOutput{ctx: s.context(), value: v}
+		}
+		return tt.Serialize(v), nil
+	case *graphql.Enum:
+		return tt.Serialize(v), nil
+	case *graphql.Interface, *graphql.Union:
+		object := resolveObjectType(s.params, tt.(graphql.Abstract), v)
+		if object == nil {
+			return nil, []gqlerrors.FormattedError{{
+				Message: fmt.Sprintf("could not resolve type of %v", t),
+				Path:    path,
+			}}
+		}
+		return s.completeObject(object, v, path)
+	case *graphql.Object:
+		return s.completeObject(tt, v, path)
+	}
+	return nil, nil
+}
+
+// context returns context of request in which items of stream are completed
+func (s streamItem) context() context.Context {
+	ctx := context.WithValue(s.streams.ctx, ContextKey, &Context{Claims: s.claims})
+	if protocol := s.params.Context.Value(ProtocolKey); protocol != nil {
+		ctx = context.WithValue(ctx, ProtocolKey, protocol)
+	}
+	return ctx
+}
+
+// completeObject executes selections of field on item
+func (s streamItem) completeObject(object *graphql.Object, v interface{}, path []interface{}) (interface{}, []gqlerrors.FormattedError) {
+	schema, err := s.streams.schemas.schema(s.params.Info.Schema, object)
+	if err != nil {
+		return nil, []gqlerrors.FormattedError{{Message: err.Error(), Path: path}}
+	}
+	selectionSet := ast.NewSelectionSet(nil)
+	for _, f := range s.params.Info.FieldASTs {
+		if f.SelectionSet != nil {
+			selectionSet.Selections = append(selectionSet.Selections, f.SelectionSet.Selections...)
+		}
+	}
+	doc := selectionDocument(s.params.Info, selectionSet)
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:  *schema,
+		Root:    v,
+		AST:     doc,
+		Args:    s.params.Info.VariableValues,
+		Context: s.context(),
+	})
+	return result.Data, prefixErrorPaths(result.Errors, path)
+}
+
+// prefixErrorPaths prepends path of resolved value to paths of errors of
+// selections executed on it
+func prefixErrorPaths(errs []gqlerrors.FormattedError, path []interface{}) []gqlerrors.FormattedError {
+	for i := range errs {
+		errs[i].Path = append(append([]interface{}{}, path...), errs[i].Path...)
+	}
+	return errs
+}
+
+// StreamFieldResolve creates a resolver of list fields which streams items from function
+// if field is marked with @stream directive and operation collects streams. Otherwise field is
+// resolved with next.
+func (d Dispatch) StreamFieldResolve(rs ResolverConfig, next graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		streams := streamsFromContext(params.Context)
+		if streams == nil || isSubscription(&params.Info) || !isListType(params.Info.ReturnType) {
+			return next(params)
+		}
+		var args map[string]interface{}
+		var ok bool
+		for _, f := range params.Info.FieldASTs {
+			if args, ok = activeDirective(f.Directives, "stream", params.Info.VariableValues); ok {
+				break
+			}
+		}
+		if !ok {
+			return next(params)
+		}
+		if err := getRouterError(params.Context); err != nil {
+			return nil, err
+		}
+		if err := d.checkDepth(params); err != nil {
+			return nil, err
+		}
+		fieldInfo := buildFieldInfoParams(params.Context, params.Info)
+		input := driver.StreamInput{
+			Function:  rs.Resolve,
+			Arguments: types.Arguments(params.Args),
+			Info: driver.StreamInfo{
+				FieldName:      fieldInfo.FieldName,
+				Path:           fieldInfo.Path,
+				ReturnType:     fieldInfo.ReturnType,
+				ParentType:     fieldInfo.ParentType,
+				Operation:      fieldInfo.Operation,
+				VariableValues: fieldInfo.VariableValues,
+			},
+			Protocol: params.Context.Value(ProtocolKey),
+		}
+		out := d.contextDriver().StreamContext(streams.ctx, input)
+		if out.Error == nil && out.Reader == nil {
+			out.Error = &driver.Error{Message: "function did not return stream"}
+		}
+		if out.Error != nil {
+			return nil, fieldError(params.Info, out.Error)
+		}
+		initialCount := directiveIntArgument(args, "initialCount")
+		items := []interface{}{}
+		for len(items) < initialCount {
+			if !out.Reader.Next() {
+				err := out.Reader.Error()
+				out.Reader.Close()
+				return items, err
+			}
+			msg := out.Reader.Read()
+			if msg.Error != nil {
+				out.Reader.Close()
+				return nil, fieldError(params.Info, msg.Error)
+			}
+			items = append(items, msg.Response)
+		}
+		label, _ := args["label"].(string)
+		streams.add(&Stream{
+			Label:  label,
+			Path:   params.Info.Path.AsArray(),
+			index:  len(items),
+			reader: out.Reader,
+			item: streamItem{
+				streams: streams,
+				params:  params,
+				claims:  claimsFromContext(params.Context),
+			},
+		})
+		return items, nil
+	}
+}
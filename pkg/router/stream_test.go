@@ -0,0 +1,111 @@
+package router_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/driver/drivertest"
+	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/types"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamFieldResolve(t *testing.T) {
+	env := router.Environment{
+		Provider: "stream",
+		Runtime:  "test",
+	}
+	mockDriver := new(drivertest.MockDriver)
+	mockDriver.On("SetSecrets", mock.Anything).Return(driver.SetSecretsOutput{})
+	mockDriver.On("Stream", mock.MatchedBy(func(in driver.StreamInput) bool {
+		return in.Function.Name == "users" && in.Info.FieldName == "users"
+	})).Return(driver.StreamOutput{
+		Reader: drivertest.NewStreamReader(
+			driver.StreamMessage{Response: map[string]interface{}{"name": "a"}},
+			driver.StreamMessage{Response: map[string]interface{}{"name": "b"}},
+			driver.StreamMessage{Response: map[string]interface{}{"name": "c"}},
+			driver.StreamMessage{Error: &driver.Error{Message: "stream failed"}},
+		),
+	})
+	mockDriver.On("FieldResolve", mock.MatchedBy(func(in driver.FieldResolveInput) bool {
+		return in.Function.Name == "users"
+	})).Return(driver.FieldResolveOutput{Response: []interface{}{
+		map[string]interface{}{"name": "a"},
+	}})
+	driver.Register(driver.Config{
+		Provider: env.Provider,
+		Runtime:  env.Runtime,
+	}, mockDriver)
+	rt, err := router.NewRouter(router.Config{
+		Environment: env,
+		Resolvers: map[string]router.ResolverConfig{
+			"Query.users": {
+				Resolve: types.Function{Name: "users"},
+			},
+		},
+		Schema: incrementalSchema,
+	})
+	require.NoError(t, err)
+	t.Run("StreamsItems", func(t *testing.T) {
+		streams := router.NewStreams(context.Background())
+		result := graphql.Do(graphql.Params{
+			Schema:        rt.Schema,
+			RequestString: `{ users @stream(initialCount: 1, label: "users") { name } }`,
+			Context:       router.WithStreams(context.Background(), streams),
+		})
+		require.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{
+			"users": []interface{}{
+				map[string]interface{}{"name": "a"},
+			},
+		}, result.Data)
+		taken := streams.Take()
+		require.Len(t, taken, 1)
+		s := taken[0]
+		defer s.Close()
+		var results []router.IncrementalResult
+		for {
+			r, ok := s.Next()
+			if !ok {
+				break
+			}
+			results = append(results, r)
+		}
+		assert.Equal(t, []router.IncrementalResult{
+			{
+				Items: []interface{}{map[string]interface{}{"name": "b"}},
+				Path:  []interface{}{"users", 1},
+				Label: "users",
+			},
+			{
+				Items: []interface{}{map[string]interface{}{"name": "c"}},
+				Path:  []interface{}{"users", 2},
+				Label: "users",
+			},
+			{
+				Path:   []interface{}{"users", 3},
+				Label:  "users",
+				Errors: results[2].Errors,
+			},
+		}, results)
+		require.Len(t, results[2].Errors, 1)
+		assert.Equal(t, "stream failed", results[2].Errors[0].Message)
+	})
+	t.Run("ResolvesWithoutStreams", func(t *testing.T) {
+		result := graphql.Do(graphql.Params{
+			Schema:        rt.Schema,
+			RequestString: `{ users @stream { name } }`,
+			Context:       context.Background(),
+		})
+		require.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{
+			"users": []interface{}{
+				map[string]interface{}{"name": "a"},
+			},
+		}, result.Data)
+	})
+}
@@ -0,0 +1,142 @@
+package router
+
+import (
+	"context"
+
+	"github.com/graphql-editor/stucco/pkg/driver"
+	"github.com/graphql-editor/stucco/pkg/types"
+)
+
+// callFunc is a single call to driver returning its output and error
+type callFunc func(context.Context) (interface{}, *driver.Error)
+
+// callWrapper is called instead of driver for every call made while
+// handling a request. It must return output of call or nil and an error.
+type callWrapper func(ctx context.Context, fn types.Function, call callFunc) (interface{}, *driver.Error)
+
+// wrappedDriver passes request calls to driver through a wrapper, subscription
+// and stream calls are passed directly to driver
+type wrappedDriver struct {
+	driver.ContextDriver
+	dri  driver.Driver
+	wrap callWrapper
+}
+
+func wrapDriver(dri driver.Driver, wrap callWrapper) driver.Driver {
+	return wrappedDriver{
+		ContextDriver: driver.WithContext(dri),
+		dri:           dri,
+		wrap:          wrap,
+	}
+}
+
+func (w wrappedDriver) Authorize(in driver.AuthorizeInput) driver.AuthorizeOutput {
+	return w.AuthorizeContext(context.Background(), in)
+}
+
+func (w wrappedDriver) AuthorizeContext(ctx context.Context, in driver.AuthorizeInput) driver.AuthorizeOutput {
+	v, err := w.wrap(ctx, in.Function, func(ctx context.Context) (interface{}, *driver.Error) {
+		out := w.ContextDriver.AuthorizeContext(ctx, in)
+		return out, out.Error
+	})
+	if v == nil {
+		return driver.AuthorizeOutput{Error: err}
+	}
+	return v.(driver.AuthorizeOutput)
+}
+
+func (w wrappedDriver) FieldResolve(in driver.FieldResolveInput) driver.FieldResolveOutput {
+	return w.FieldResolveContext(context.Background(), in)
+}
+
+func (w wrappedDriver) FieldResolveContext(ctx context.Context, in driver.FieldResolveInput) driver.FieldResolveOutput {
+	v, err := w.wrap(ctx, in.Function, func(ctx context.Context) (interface{}, *driver.Error) {
+		out := w.ContextDriver.FieldResolveContext(ctx, in)
+		return out, out.Error
+	})
+	if v == nil {
+		return driver.FieldResolveOutput{Error: err}
+	}
+	return v.(driver.FieldResolveOutput)
+}
+
+func (w wrappedDriver) FieldResolveBatch(in driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	return w.FieldResolveBatchContext(context.Background(), in)
+}
+
+// FieldResolveBatchContext wraps a batch as a whole, only
+// errors of a whole batch are seen by wrapper
+func (w wrappedDriver) FieldResolveBatchContext(ctx context.Context, in driver.FieldResolveBatchInput) driver.FieldResolveBatchOutput {
+	var fn types.Function
+	if len(in.Inputs) > 0 {
+		fn = in.Inputs[0].Function
+	}
+	v, err := w.wrap(ctx, fn, func(ctx context.Context) (interface{}, *driver.Error) {
+		out := driver.FieldResolveBatch(ctx, w.dri, in)
+		return out, out.Error
+	})
+	if v == nil {
+		return driver.FieldResolveBatchOutput{Error: err}
+	}
+	return v.(driver.FieldResolveBatchOutput)
+}
+
+func (w wrappedDriver) InterfaceResolveType(in driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
+	return w.InterfaceResolveTypeContext(context.Background(), in)
+}
+
+func (w wrappedDriver) InterfaceResolveTypeContext(ctx context.Context, in driver.InterfaceResolveTypeInput) driver.InterfaceResolveTypeOutput {
+	v, err := w.wrap(ctx, in.Function, func(ctx context.Context) (interface{}, *driver.Error) {
+		out := w.ContextDriver.InterfaceResolveTypeContext(ctx, in)
+		return out, out.Error
+	})
+	if v == nil {
+		return driver.InterfaceResolveTypeOutput{Error: err}
+	}
+	return v.(driver.InterfaceResolveTypeOutput)
+}
+
+func (w wrappedDriver) ScalarParse(in driver.ScalarParseInput) driver.ScalarParseOutput {
+	return w.ScalarParseContext(context.Background(), in)
+}
+
+func (w wrappedDriver) ScalarParseContext(ctx context.Context, in driver.ScalarParseInput) driver.ScalarParseOutput {
+	v, err := w.wrap(ctx, in.Function, func(ctx context.Context) (interface{}, *driver.Error) {
+		out := w.ContextDriver.ScalarParseContext(ctx, in)
+		return out, out.Error
+	})
+	if v == nil {
+		return driver.ScalarParseOutput{Error: err}
+	}
+	return v.(driver.ScalarParseOutput)
+}
+
+func (w wrappedDriver) ScalarSerialize(in driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
+	return w.ScalarSerializeContext(context.Background(), in)
+}
+
+func (w wrappedDriver) ScalarSerializeContext(ctx context.Context, in driver.ScalarSerializeInput) driver.ScalarSerializeOutput {
+	v, err := w.wrap(ctx, in.Function, func(ctx context.Context) (interface{}, *driver.Error) {
+		out := w.ContextDriver.ScalarSerializeContext(ctx, in)
+		return out, out.Error
+	})
+	if v == nil {
+		return driver.ScalarSerializeOutput{Error: err}
+	}
+	return v.(driver.ScalarSerializeOutput)
+}
+
+func (w wrappedDriver) UnionResolveType(in driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
+	return w.UnionResolveTypeContext(context.Background(), in)
+}
+
+func (w wrappedDriver) UnionResolveTypeContext(ctx context.Context, in driver.UnionResolveTypeInput) driver.UnionResolveTypeOutput {
+	v, err := w.wrap(ctx, in.Function, func(ctx context.Context) (interface{}, *driver.Error) {
+		out := w.ContextDriver.UnionResolveTypeContext(ctx, in)
+		return out, out.Error
+	})
+	if v == nil {
+		return driver.UnionResolveTypeOutput{Error: err}
+	}
+	return v.(driver.UnionResolveTypeOutput)
+}
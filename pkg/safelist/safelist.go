@@ -0,0 +1,240 @@
+// Package safelist implements a list of operations allowed by server
+package safelist
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/printer"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// ManifestVersion is a version of manifest written by BuildManifest
+const ManifestVersion = 1
+
+// Operation is an allowed operation document
+type Operation struct {
+	// ID of an operation, clients can send ID instead of a document
+	ID string `json:"id"`
+	// Name of the first operation in document
+	Name string `json:"name,omitempty"`
+	// Hash is a hex encoded sha256 hash of normalized document
+	Hash string `json:"hash,omitempty"`
+	// Body is an operation document
+	Body string `json:"body"`
+}
+
+// Manifest is a list of allowed operations
+type Manifest struct {
+	Version    int         `json:"version"`
+	Operations []Operation `json:"operations"`
+}
+
+func parse(query string) (*ast.Document, error) {
+	return parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(query),
+			Name: "GraphQL request",
+		}),
+	})
+}
+
+func normalize(doc *ast.Document) string {
+	printed, _ := printer.Print(doc).(string)
+	return printed
+}
+
+// Normalize returns document with whitespace, comments and formatting differences removed
+func Normalize(query string) (string, error) {
+	doc, err := parse(query)
+	if err != nil {
+		return "", err
+	}
+	return normalize(doc), nil
+}
+
+// Hash returns hex encoded sha256 hash of normalized document
+func Hash(query string) (string, error) {
+	normalized, err := Normalize(query)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func operationName(doc *ast.Document) string {
+	for _, def := range doc.Definitions {
+		if op, ok := def.(*ast.OperationDefinition); ok && op.Name != nil {
+			return op.Name.Value
+		}
+	}
+	return ""
+}
+
+// NewOperation creates an operation from document, if id is empty, hash is used as an id
+func NewOperation(id, body string) (Operation, error) {
+	doc, err := parse(body)
+	if err != nil {
+		return Operation{}, err
+	}
+	sum := sha256.Sum256([]byte(normalize(doc)))
+	op := Operation{
+		ID:   id,
+		Name: operationName(doc),
+		Hash: hex.EncodeToString(sum[:]),
+		Body: body,
+	}
+	if op.ID == "" {
+		op.ID = op.Hash
+	}
+	return op, nil
+}
+
+// BuildManifest creates manifest from all .graphql and .gql files in directory. Operation
+// id is a path of file relative to dir without extension.
+func BuildManifest(dir string) (*Manifest, error) {
+	m := Manifest{Version: ManifestVersion}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		ext := filepath.Ext(path)
+		if ext != ".graphql" && ext != ".gql" {
+			return nil
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		op, err := NewOperation(filepath.ToSlash(strings.TrimSuffix(rel, ext)), string(b))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		m.Operations = append(m.Operations, op)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(m.Operations, func(i, j int) bool {
+		return m.Operations[i].ID < m.Operations[j].ID
+	})
+	return &m, nil
+}
+
+// LoadManifest loads manifest from JSON file or builds it from directory of documents
+func LoadManifest(path string) (*Manifest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return BuildManifest(path)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for i, op := range m.Operations {
+		nop, err := NewOperation(op.ID, op.Body)
+		if err != nil {
+			return nil, fmt.Errorf("operation %s: %w", op.ID, err)
+		}
+		if op.Hash != "" && op.Hash != nop.Hash {
+			return nil, fmt.Errorf("operation %s: hash does not match document", op.ID)
+		}
+		if op.Name != "" {
+			nop.Name = op.Name
+		}
+		m.Operations[i] = nop
+	}
+	return &m, nil
+}
+
+// Validate checks that all operations in manifest are valid against schema
+func (m *Manifest) Validate(schema graphql.Schema) error {
+	var errs []string
+	for _, op := range m.Operations {
+		doc, err := parse(op.Body)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", op.ID, err.Error()))
+			continue
+		}
+		vr := graphql.ValidateDocument(&schema, doc, nil)
+		for _, verr := range vr.Errors {
+			errs = append(errs, fmt.Sprintf("%s: %s", op.ID, verr.Message))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// Safelist checks if operations are allowed
+type Safelist struct {
+	// Strict rejects all operations that are not in safelist
+	Strict bool
+
+	byID   map[string]Operation
+	byHash map[string]Operation
+	// allowed caches results of lookups by raw query
+	allowed sync.Map
+}
+
+// New creates safelist from manifest
+func New(m *Manifest, strict bool) *Safelist {
+	s := &Safelist{
+		Strict: strict,
+		byID:   make(map[string]Operation, len(m.Operations)),
+		byHash: make(map[string]Operation, len(m.Operations)),
+	}
+	for _, op := range m.Operations {
+		s.byID[op.ID] = op
+		s.byHash[op.Hash] = op
+	}
+	return s
+}
+
+// Operation returns operation document by id
+func (s *Safelist) Operation(id string) (string, bool) {
+	op, ok := s.byID[id]
+	return op.Body, ok
+}
+
+// Allowed returns true if query is in safelist
+func (s *Safelist) Allowed(query string) bool {
+	if v, ok := s.allowed.Load(query); ok {
+		return v.(bool)
+	}
+	hash, err := Hash(query)
+	_, ok := s.byHash[hash]
+	ok = ok && err == nil
+	if ok {
+		// cache only allowed queries, so that rejected
+		// queries cannot grow cache
+		s.allowed.Store(query, ok)
+	}
+	return ok
+}
@@ -0,0 +1,81 @@
+package safelist_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/safelist"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashIgnoresFormatting(t *testing.T) {
+	a, err := safelist.Hash("query Q { field }")
+	require.NoError(t, err)
+	b, err := safelist.Hash(`
+# comment
+query Q {
+	field
+}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, a, b)
+	c, err := safelist.Hash("query Q { other }")
+	require.NoError(t, err)
+	assert.NotEqual(t, a, c)
+}
+
+func TestBuildAndLoadManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stucco-safelist")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "ops", "nested"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ops", "a.graphql"), []byte("query A { field }"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ops", "nested", "b.gql"), []byte("query B { other }"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ops", "README.md"), []byte("not an operation"), 0644))
+
+	m, err := safelist.BuildManifest(filepath.Join(dir, "ops"))
+	require.NoError(t, err)
+	require.Len(t, m.Operations, 2)
+	assert.Equal(t, "a", m.Operations[0].ID)
+	assert.Equal(t, "A", m.Operations[0].Name)
+	assert.Equal(t, "nested/b", m.Operations[1].ID)
+
+	b, err := json.Marshal(m)
+	require.NoError(t, err)
+	manifest := filepath.Join(dir, "manifest.json")
+	require.NoError(t, ioutil.WriteFile(manifest, b, 0644))
+	loaded, err := safelist.LoadManifest(manifest)
+	require.NoError(t, err)
+	assert.Equal(t, m, loaded)
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{Type: graphql.String},
+			},
+		}),
+	})
+	require.NoError(t, err)
+	err = m.Validate(schema)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "nested/b")
+	}
+}
+
+func TestSafelist(t *testing.T) {
+	op, err := safelist.NewOperation("", "query A { field }")
+	require.NoError(t, err)
+	s := safelist.New(&safelist.Manifest{Operations: []safelist.Operation{op}}, true)
+	assert.True(t, s.Allowed("query A {\n  field\n}"))
+	assert.False(t, s.Allowed("query A { other }"))
+	assert.False(t, s.Allowed("not a query"))
+	body, ok := s.Operation(op.Hash)
+	assert.True(t, ok)
+	assert.Equal(t, "query A { field }", body)
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/graphql-editor/stucco/pkg/router"
+	"k8s.io/klog"
+)
+
+const defaultReloadInterval = 2 * time.Second
+
+// HandlerBuilder creates new http handler from config
+type HandlerBuilder func(Config) (http.Handler, error)
+
+type reloadableHandler struct {
+	build   HandlerBuilder
+	handler atomic.Value
+}
+
+// ServeHTTP implements http.Handler. Request is served by handler that was current when
+// request started, so in-flight requests and subscriptions finish on the old router.
+func (r *reloadableHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	r.handler.Load().(http.Handler).ServeHTTP(rw, req)
+}
+
+// ReloaderConfig configures reloader
+type ReloaderConfig struct {
+	// Load returns current config
+	Load func() (Config, error)
+	// Interval between checks for changes, defaults to 2 seconds
+	Interval time.Duration
+	// PollRemote enables fetching schema from url on each check
+	PollRemote bool
+}
+
+// Reloader rebuilds handlers when config or schema changes and swaps them
+// only if all of them were built successfully
+type Reloader struct {
+	ReloaderConfig
+	mu          sync.Mutex
+	config      Config
+	fingerprint string
+	handlers    []*reloadableHandler
+}
+
+// NewReloader creates new reloader and loads initial config
+func NewReloader(c ReloaderConfig) (*Reloader, error) {
+	r := &Reloader{ReloaderConfig: c}
+	cfg, fingerprint, err := r.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	r.config, r.fingerprint = cfg, fingerprint
+	return r, nil
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+// loadConfig loads config and computes fingerprint of config and schema source
+func (r *Reloader) loadConfig() (Config, string, error) {
+	cfg, err := r.Load()
+	if err != nil {
+		return cfg, "", err
+	}
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return cfg, "", err
+	}
+	schema := cfg.Schema
+	if env := os.Getenv(router.SchemaEnv); schema == "" && env != "" {
+		schema = env
+	}
+	if !isURL(schema) || r.PollRemote {
+		if schema, err = cfg.RawSchema(); err != nil {
+			return cfg, "", err
+		}
+	}
+	h := sha256.New()
+	h.Write(b)
+	h.Write([]byte(schema))
+	return cfg, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Handler returns a handler built with current config that is rebuilt on reload
+func (r *Reloader) Handler(build HandlerBuilder) (http.Handler, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	h, err := build(r.config)
+	if err != nil {
+		return nil, err
+	}
+	rh := &reloadableHandler{build: build}
+	rh.handler.Store(h)
+	r.handlers = append(r.handlers, rh)
+	return rh, nil
+}
+
+// Reload rebuilds handlers if config or schema changed. It returns true
+// if handlers were swapped.
+func (r *Reloader) Reload() (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cfg, fingerprint, err := r.loadConfig()
+	if err != nil || fingerprint == r.fingerprint {
+		return false, err
+	}
+	handlers := make([]http.Handler, 0, len(r.handlers))
+	for _, rh := range r.handlers {
+		h, err := rh.build(cfg)
+		if err != nil {
+			return false, err
+		}
+		handlers = append(handlers, h)
+	}
+	for i, rh := range r.handlers {
+		rh.handler.Store(handlers[i])
+	}
+	r.config, r.fingerprint = cfg, fingerprint
+	return true, nil
+}
+
+// Watch checks for changes until context is done
+func (r *Reloader) Watch(ctx context.Context) {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = defaultReloadInterval
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		reloaded, err := r.Reload()
+		switch {
+		case err != nil:
+			klog.Errorf("config reload failed, keeping current router: %v", err)
+		case reloaded:
+			klog.Info("config reloaded")
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package server_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloader(t *testing.T) {
+	cfg := server.Config{}
+	cfg.Schema = "type Query { a: String } schema { query: Query }"
+	var loadErr error
+	reloader, err := server.NewReloader(server.ReloaderConfig{
+		Load: func() (server.Config, error) {
+			return cfg, loadErr
+		},
+	})
+	require.NoError(t, err)
+	builds := 0
+	h, err := reloader.Handler(func(c server.Config) (http.Handler, error) {
+		if c.Schema == "invalid" {
+			return nil, errors.New("invalid schema")
+		}
+		builds++
+		schema := c.Schema
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.Write([]byte(schema))
+		}), nil
+	})
+	require.NoError(t, err)
+	body := func() string {
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/", nil))
+		return rw.Body.String()
+	}
+	assert.Equal(t, cfg.Schema, body())
+
+	reloaded, err := reloader.Reload()
+	assert.NoError(t, err)
+	assert.False(t, reloaded)
+	assert.Equal(t, 1, builds)
+
+	oldSchema := cfg.Schema
+	cfg.Schema = "invalid"
+	reloaded, err = reloader.Reload()
+	assert.Error(t, err)
+	assert.False(t, reloaded)
+	assert.Equal(t, oldSchema, body())
+
+	loadErr = errors.New("load error")
+	cfg.Schema = "type Query { b: String } schema { query: Query }"
+	_, err = reloader.Reload()
+	assert.Error(t, err)
+	assert.Equal(t, oldSchema, body())
+
+	loadErr = nil
+	reloaded, err = reloader.Reload()
+	assert.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, cfg.Schema, body())
+	assert.Equal(t, 2, builds)
+}
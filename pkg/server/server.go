@@ -20,7 +20,9 @@ import (
 	gqlhandler "github.com/graphql-editor/stucco/pkg/handlers"
 	azuredriver "github.com/graphql-editor/stucco/pkg/providers/azure/driver"
 	"github.com/graphql-editor/stucco/pkg/router"
+	"github.com/graphql-editor/stucco/pkg/safelist"
 	"github.com/graphql-editor/stucco/pkg/security"
+	"github.com/graphql-go/graphql"
 	"k8s.io/klog"
 )
 
@@ -238,12 +240,82 @@ func checkPointerBoolDefaultTrue(b *bool) bool {
 	return *b
 }
 
+// PersistedQueriesConfig enables automatic persisted queries
+type PersistedQueriesConfig struct {
+	// CacheSize is a maximum number of queries kept in memory
+	CacheSize int `json:"cacheSize,omitempty"`
+	// Directory in which queries are stored, if empty queries are kept in memory
+	Directory string `json:"directory,omitempty"`
+}
+
+func (p *PersistedQueriesConfig) store() (handlers.PersistedQueryStore, error) {
+	if p == nil {
+		return nil, nil
+	}
+	if p.Directory != "" {
+		return handlers.NewFilePersistedQueryStore(p.Directory)
+	}
+	return handlers.NewLRUPersistedQueryStore(p.CacheSize), nil
+}
+
+// SafelistConfig limits operations that can be executed by server
+type SafelistConfig struct {
+	// Manifest is a path to JSON manifest or a directory with operation documents
+	Manifest string `json:"manifest"`
+	// Strict rejects all operations that are not in manifest
+	Strict bool `json:"strict,omitempty"`
+}
+
+// safelist loads manifest and checks that all operations are valid against schema
+func (s *SafelistConfig) safelist(schema graphql.Schema) (*safelist.Safelist, error) {
+	if s == nil {
+		return nil, nil
+	}
+	m, err := safelist.LoadManifest(s.Manifest)
+	if err == nil {
+		err = m.Validate(schema)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return safelist.New(m, s.Strict), nil
+}
+
+// PubSubConfig configures publishing to pub/sub broker over http
+type PubSubConfig struct {
+	// PublishToken is a bearer token authorizing requests to publish endpoint
+	PublishToken string `json:"publishToken,omitempty"`
+}
+
+// UploadsConfig limits size of files uploaded with multipart requests
+type UploadsConfig struct {
+	// MaxSize is a maximum size of multipart request in bytes
+	MaxSize int64 `json:"maxSize,omitempty"`
+	// MaxFileSize is a maximum size of a single file in bytes
+	MaxFileSize int64 `json:"maxFileSize,omitempty"`
+	// Memory is a number of bytes kept in memory before files are stored in temporary files
+	Memory int64 `json:"memory,omitempty"`
+}
+
+// BatchConfig configures execution of batched requests
+type BatchConfig struct {
+	// MaxSize is a maximum number of operations in batch, negative value disables batching
+	MaxSize int `json:"maxSize,omitempty"`
+	// Parallelism is a number of operations executed concurrently
+	Parallelism int `json:"parallelism,omitempty"`
+}
+
 // Config is a GraphQL http server configuration
 type Config struct {
 	router.Config
-	Pretty             *bool              `json:"pretty"`
-	GraphiQL           *bool              `json:"graphiql"`
-	DefaultEnvironment router.Environment `json:"defaultEnvironment"`
+	Pretty             *bool                   `json:"pretty"`
+	GraphiQL           *bool                   `json:"graphiql"`
+	DefaultEnvironment router.Environment      `json:"defaultEnvironment"`
+	PersistedQueries   *PersistedQueriesConfig `json:"persistedQueries,omitempty"`
+	Safelist           *SafelistConfig         `json:"safelist,omitempty"`
+	PubSub             *PubSubConfig           `json:"pubsub,omitempty"`
+	Uploads            *UploadsConfig          `json:"uploads,omitempty"`
+	Batch              *BatchConfig            `json:"batch,omitempty"`
 }
 
 // UnmarshalJSON implements json unmarshaler
@@ -275,13 +347,33 @@ func New(c Config) (httpHandler http.Handler, err error) {
 	if err == nil {
 		rt, err = router.NewRouter(c.Config)
 	}
+	var persistedQueries handlers.PersistedQueryStore
 	if err == nil {
-		httpHandler = handlers.WithProtocolInContext(gqlhandler.New(gqlhandler.Config{
-			RouterConfig: c.Config,
-			Schema:       &rt.Schema,
-			Pretty:       checkPointerBoolDefaultTrue(c.Pretty),
-			GraphiQL:     checkPointerBoolDefaultTrue(c.GraphiQL),
-		}))
+		persistedQueries, err = c.PersistedQueries.store()
+	}
+	var sl *safelist.Safelist
+	if err == nil {
+		sl, err = c.Safelist.safelist(rt.Schema)
+	}
+	if err == nil {
+		cfg := gqlhandler.Config{
+			RouterConfig:     c.Config,
+			Schema:           &rt.Schema,
+			Pretty:           checkPointerBoolDefaultTrue(c.Pretty),
+			GraphiQL:         checkPointerBoolDefaultTrue(c.GraphiQL),
+			PersistedQueries: persistedQueries,
+			Safelist:         sl,
+		}
+		if c.Uploads != nil {
+			cfg.MaxUploadSize = c.Uploads.MaxSize
+			cfg.MaxUploadFileSize = c.Uploads.MaxFileSize
+			cfg.UploadMemory = c.Uploads.Memory
+		}
+		if c.Batch != nil {
+			cfg.MaxBatchSize = c.Batch.MaxSize
+			cfg.BatchParallelism = c.Batch.Parallelism
+		}
+		httpHandler = handlers.WithProtocolInContext(gqlhandler.New(cfg))
 	}
 	return
 }
@@ -308,12 +400,27 @@ func NewWebhookHandler(c Config) (httpHandler http.Handler, err error) {
 	return
 }
 
+// NewPublishHandler returns new handler publishing to topics of subscriptions through
+// broker of config, which must be shared with router
+func NewPublishHandler(c Config) (http.Handler, error) {
+	if c.Broker == nil {
+		return nil, errors.New("publish handler requires a pub/sub broker")
+	}
+	var token string
+	if c.PubSub != nil {
+		token = c.PubSub.PublishToken
+	}
+	return gqlhandler.NewPublishHandler(c.Broker, token), nil
+}
+
 // Server default simple server that has two endpoints. /graphql which uses Handler as a handler
-// and /health that uses Health as a handler or just returns 200.
+// and /health that uses Health as a handler or just returns 200. If PublishHandler is set, it
+// handles /publish.
 // It handles SIGTERM.
 type Server struct {
 	Handler        http.Handler
 	WebhookHandler http.Handler
+	PublishHandler http.Handler
 	Health         http.Handler
 	Addr           string
 }
@@ -332,6 +439,12 @@ func (s *Server) handler(rw http.ResponseWriter, r *http.Request) {
 		s.Handler.ServeHTTP(rw, r)
 	case "/health":
 		s.health(rw, r)
+	case "/publish":
+		if s.PublishHandler == nil {
+			http.NotFound(rw, r)
+			return
+		}
+		s.PublishHandler.ServeHTTP(rw, r)
 	default:
 		if s.WebhookHandler != nil && strings.HasPrefix(r.URL.Path, "/webhook/") {
 			s.WebhookHandler.ServeHTTP(rw, r)
@@ -11,6 +11,7 @@ type FragmentDefinition struct {
 // Selection is a represents a field or fragment requested by client
 type Selection struct {
 	Name         string              `json:"name,omitempty"`
+	Alias        string              `json:"alias,omitempty"`
 	Arguments    Arguments           `json:"arguments,omitempty"`
 	Directives   Directives          `json:"directives,omitempty"`
 	SelectionSet Selections          `json:"selectionSet,omitempty"`
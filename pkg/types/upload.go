@@ -0,0 +1,65 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+)
+
+// Upload is a file sent in GraphQL multipart request
+type Upload struct {
+	Filename string `json:"filename"`
+	MimeType string `json:"mimeType"`
+	Size     int64  `json:"size"`
+	open     func() (io.ReadCloser, error)
+}
+
+// NewUpload creates upload with content returned by open
+func NewUpload(filename, mimeType string, size int64, open func() (io.ReadCloser, error)) *Upload {
+	return &Upload{
+		Filename: filename,
+		MimeType: mimeType,
+		Size:     size,
+		open:     open,
+	}
+}
+
+// NewUploadFromBytes creates upload with content in memory
+func NewUploadFromBytes(filename, mimeType string, content []byte) *Upload {
+	return NewUpload(filename, mimeType, int64(len(content)), func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(content)), nil
+	})
+}
+
+// Open returns a reader streaming content of file
+func (u *Upload) Open() (io.ReadCloser, error) {
+	if u.open == nil {
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	}
+	return u.open()
+}
+
+// Content reads whole content of file
+func (u *Upload) Content() ([]byte, error) {
+	r, err := u.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// MarshalJSON encodes upload as an object with base64 encoded content
+func (u *Upload) MarshalJSON() ([]byte, error) {
+	content, err := u.Content()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		Filename string `json:"filename"`
+		MimeType string `json:"mimeType"`
+		Size     int64  `json:"size"`
+		Content  []byte `json:"content"`
+	}{u.Filename, u.MimeType, u.Size, content})
+}
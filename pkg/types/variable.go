@@ -8,5 +8,6 @@ type Variable struct {
 // VariableDefinition client defined variable
 type VariableDefinition struct {
 	Variable     Variable    `json:"variable"`
+	Type         *TypeRef    `json:"type,omitempty"`
 	DefaultValue interface{} `json:"defaultValue,omitempty"`
 }
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValuePath is a parsed path of a value, elements are either map keys or list indices
+type ValuePath []interface{}
+
+// ParseValuePath parses paths in form of a.b[0].c with optional $ root
+func ParseValuePath(p string) (ValuePath, error) {
+	p = strings.TrimPrefix(strings.TrimPrefix(p, "$"), ".")
+	var vp ValuePath
+	for p != "" {
+		switch {
+		case p[0] == '[':
+			end := strings.IndexByte(p, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated index in %s", p)
+			}
+			idx, err := strconv.Atoi(p[1:end])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("%s is not a valid index", p[1:end])
+			}
+			vp = append(vp, idx)
+			p = p[end+1:]
+		case p[0] == '.':
+			p = p[1:]
+			if p == "" || p[0] == '.' || p[0] == '[' {
+				return nil, errors.New("empty key in path")
+			}
+		default:
+			end := strings.IndexAny(p, ".[")
+			if end == -1 {
+				end = len(p)
+			}
+			vp = append(vp, p[:end])
+			p = p[end:]
+		}
+	}
+	return vp, nil
+}
+
+// Get returns value at path or nil if value does not exist
+func (vp ValuePath) Get(v interface{}) interface{} {
+	for _, k := range vp {
+		rv := reflect.ValueOf(v)
+		for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+			rv = rv.Elem()
+		}
+		switch key := k.(type) {
+		case string:
+			if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+				return nil
+			}
+			rv = rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()))
+		case int:
+			if (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) || key >= rv.Len() {
+				return nil
+			}
+			rv = rv.Index(key)
+		}
+		if !rv.IsValid() {
+			return nil
+		}
+		v = rv.Interface()
+	}
+	return v
+}
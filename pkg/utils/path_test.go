@@ -0,0 +1,38 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/graphql-editor/stucco/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValuePath(t *testing.T) {
+	v := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": []interface{}{"c", map[string]interface{}{"d": 1}},
+		},
+	}
+	data := []struct {
+		path     string
+		expected interface{}
+	}{
+		{"a.b[0]", "c"},
+		{"$.a.b[1].d", 1},
+		{"a.b[2]", nil},
+		{"a.x.y", nil},
+		{"$", v},
+	}
+	for _, tt := range data {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := utils.ParseValuePath(tt.path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, p.Get(v))
+		})
+	}
+	for _, invalid := range []string{"a[x]", "a[1", "a..b", "a[-1]"} {
+		_, err := utils.ParseValuePath(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/graphql-editor/stucco_proto
+
+go 1.16
+
+require (
+	google.golang.org/grpc v1.37.1
+	google.golang.org/protobuf v1.26.0
+)